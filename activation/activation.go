@@ -0,0 +1,342 @@
+package activation
+
+import (
+    "fmt"
+    "math"
+)
+
+/**
+ * An activation determines what a neuron outputs for a weighted sum.
+ *
+ * Derivative is taken with respect to the weighted sum, i.e. the same value
+ * that is passed to Forward. Activations that do not have a useful derivative,
+ * like Step and Sign, report 0.
+ */
+type Activation interface {
+    Forward (x float64) float64
+    Derivative (x float64) float64
+}
+
+/**
+ * An activation whose output for one neuron depends on every neuron in the
+ * layer, e.g. Softmax.
+ *
+ * BackwardVector takes the gradient with respect to the outputs and returns
+ * the gradient with respect to the weighted sums.
+ */
+type Vector interface {
+    Activation
+    ForwardVector (x []float64) []float64
+    BackwardVector (x, gradient []float64) []float64
+}
+
+/**
+ * Activate a whole layer of weighted sums.
+ */
+func ForwardAll (a Activation, x []float64) []float64 {
+    if v, ok := a.(Vector); ok {
+        return v.ForwardVector(x)
+    }
+    y := make([]float64, len(x))
+    for i := 0; i < len(x); i++ {
+        y[i] = a.Forward(x[i])
+    }
+    return y
+}
+
+/**
+ * Turn the gradient with respect to a layer's outputs into the gradient with
+ * respect to its weighted sums.
+ */
+func BackwardAll (a Activation, x, gradient []float64) []float64 {
+    if v, ok := a.(Vector); ok {
+        return v.BackwardVector(x, gradient)
+    }
+    g := make([]float64, len(x))
+    for i := 0; i < len(x); i++ {
+        g[i] = gradient[i] * a.Derivative(x[i])
+    }
+    return g
+}
+
+/**
+ * Fire (1) when the sum is above the threshold, otherwise do not fire (0).
+ */
+type Step struct {
+    Threshold float64
+}
+
+func (a Step) Forward (x float64) float64 {
+    if (x > a.Threshold) {
+        return 1
+    } else {
+        return 0
+    }
+}
+
+func (a Step) Derivative (x float64) float64 {
+    return 0
+}
+
+/**
+ * Return 1 when the sum is positive, otherwise -1.
+ */
+type Sign struct{}
+
+func (a Sign) Forward (x float64) float64 {
+    if (x > 0) {
+        return 1
+    } else {
+        return -1
+    }
+}
+
+func (a Sign) Derivative (x float64) float64 {
+    return 0
+}
+
+/**
+ * Return the sum as-is, i.e. no activation.
+ */
+type Identity struct{}
+
+func (a Identity) Forward (x float64) float64 {
+    return x
+}
+
+func (a Identity) Derivative (x float64) float64 {
+    return 1
+}
+
+/**
+ * The logistic function, squashing any sum into (0, 1).
+ */
+type Sigmoid struct{}
+
+func (a Sigmoid) Forward (x float64) float64 {
+    return 1 / (1 + math.Exp(-x))
+}
+
+func (a Sigmoid) Derivative (x float64) float64 {
+    s := a.Forward(x)
+    return s * (1 - s)
+}
+
+/**
+ * The hyperbolic tangent, squashing any sum into (-1, 1).
+ */
+type Tanh struct{}
+
+func (a Tanh) Forward (x float64) float64 {
+    return math.Tanh(x)
+}
+
+func (a Tanh) Derivative (x float64) float64 {
+    t := math.Tanh(x)
+    return 1 - (t * t)
+}
+
+/**
+ * Rectified linear unit: the sum when positive, otherwise 0.
+ */
+type ReLU struct{}
+
+func (a ReLU) Forward (x float64) float64 {
+    if (x > 0) {
+        return x
+    } else {
+        return 0
+    }
+}
+
+func (a ReLU) Derivative (x float64) float64 {
+    if (x > 0) {
+        return 1
+    } else {
+        return 0
+    }
+}
+
+/**
+ * Like ReLU, but negative sums are scaled by Alpha rather than dropped.
+ */
+type LeakyReLU struct {
+    Alpha float64
+}
+
+func (a LeakyReLU) Forward (x float64) float64 {
+    if (x > 0) {
+        return x
+    } else {
+        return a.Alpha * x
+    }
+}
+
+func (a LeakyReLU) Derivative (x float64) float64 {
+    if (x > 0) {
+        return 1
+    } else {
+        return a.Alpha
+    }
+}
+
+/**
+ * Exponential linear unit: the sum when positive, otherwise it eases towards
+ * -Alpha.
+ */
+type ELU struct {
+    Alpha float64
+}
+
+func (a ELU) Forward (x float64) float64 {
+    if (x > 0) {
+        return x
+    } else {
+        return a.Alpha * (math.Exp(x) - 1)
+    }
+}
+
+func (a ELU) Derivative (x float64) float64 {
+    if (x > 0) {
+        return 1
+    } else {
+        return a.Alpha * math.Exp(x)
+    }
+}
+
+/**
+ * A smooth version of ReLU: log(1 + e^x).
+ */
+type Softplus struct{}
+
+func (a Softplus) Forward (x float64) float64 {
+    // For large sums e^x overflows, but log(1 + e^x) is x by then anyway.
+    if (x > 30) {
+        return x
+    }
+    return math.Log1p(math.Exp(x))
+}
+
+func (a Softplus) Derivative (x float64) float64 {
+    return Sigmoid{}.Forward(x)
+}
+
+/**
+ * Turn a layer's sums into probabilities that add up to 1.
+ *
+ * On its own a single neuron is always certain, so Forward returns 1 and
+ * Derivative 0; use it on a whole layer through ForwardAll and BackwardAll.
+ */
+type Softmax struct{}
+
+func (a Softmax) Forward (x float64) float64 {
+    return 1
+}
+
+func (a Softmax) Derivative (x float64) float64 {
+    return 0
+}
+
+func (a Softmax) ForwardVector (x []float64) []float64 {
+    y := make([]float64, len(x))
+    if (len(x) == 0) {
+        return y
+    }
+
+    // Subtracting the largest sum keeps e^x from overflowing.
+    max := x[0]
+    for i := 1; i < len(x); i++ {
+        max = math.Max(max, x[i])
+    }
+    var sum float64 = 0
+    for i := 0; i < len(x); i++ {
+        y[i] = math.Exp(x[i] - max)
+        sum = sum + y[i]
+    }
+    for i := 0; i < len(y); i++ {
+        y[i] = y[i] / sum
+    }
+    return y
+}
+
+func (a Softmax) BackwardVector (x, gradient []float64) []float64 {
+    s := a.ForwardVector(x)
+    var dot float64 = 0
+    for i := 0; i < len(s); i++ {
+        dot = dot + (s[i] * gradient[i])
+    }
+    g := make([]float64, len(s))
+    for i := 0; i < len(s); i++ {
+        g[i] = s[i] * (gradient[i] - dot)
+    }
+    return g
+}
+
+/**
+ * A description of an activation that can be saved, e.g. as JSON, and turned
+ * back into the activation with FromSpec.
+ *
+ * Param holds the threshold of a Step and the alpha of a LeakyReLU or ELU.
+ */
+type Spec struct {
+    Name string `json:"name"`
+    Param float64 `json:"param,omitempty"`
+}
+
+/**
+ * Describe one of the built-in activations.
+ */
+func Describe (a Activation) (Spec, error) {
+    switch a := a.(type) {
+    case Step:
+        return Spec{Name: "step", Param: a.Threshold}, nil
+    case Sign:
+        return Spec{Name: "sign"}, nil
+    case Identity:
+        return Spec{Name: "identity"}, nil
+    case Sigmoid:
+        return Spec{Name: "sigmoid"}, nil
+    case Tanh:
+        return Spec{Name: "tanh"}, nil
+    case ReLU:
+        return Spec{Name: "relu"}, nil
+    case LeakyReLU:
+        return Spec{Name: "leakyrelu", Param: a.Alpha}, nil
+    case ELU:
+        return Spec{Name: "elu", Param: a.Alpha}, nil
+    case Softplus:
+        return Spec{Name: "softplus"}, nil
+    case Softmax:
+        return Spec{Name: "softmax"}, nil
+    }
+    return Spec{}, fmt.Errorf("activation: cannot describe %T", a)
+}
+
+/**
+ * Create the activation a Spec describes.
+ */
+func FromSpec (s Spec) (Activation, error) {
+    switch s.Name {
+    case "step":
+        return Step{Threshold: s.Param}, nil
+    case "sign":
+        return Sign{}, nil
+    case "identity":
+        return Identity{}, nil
+    case "sigmoid":
+        return Sigmoid{}, nil
+    case "tanh":
+        return Tanh{}, nil
+    case "relu":
+        return ReLU{}, nil
+    case "leakyrelu":
+        return LeakyReLU{Alpha: s.Param}, nil
+    case "elu":
+        return ELU{Alpha: s.Param}, nil
+    case "softplus":
+        return Softplus{}, nil
+    case "softmax":
+        return Softmax{}, nil
+    }
+    return nil, fmt.Errorf("activation: unknown activation %q", s.Name)
+}
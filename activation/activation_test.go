@@ -0,0 +1,180 @@
+package activation
+
+import (
+    "math"
+    "testing"
+)
+
+func TestStep(t *testing.T) {
+    a := Step{Threshold: 0.5}
+
+    var got float64
+
+    got = a.Forward(0.51)
+    if got != 1 {
+        t.Errorf("Step{0.5}.Forward(%v) == %v, want %v", 0.51, got, 1)
+    }
+
+    got = a.Forward(0.49)
+    if got != 0 {
+        t.Errorf("Step{0.5}.Forward(%v) == %v, want %v", 0.49, got, 0)
+    }
+}
+
+func TestSign(t *testing.T) {
+    a := Sign{}
+
+    var got float64
+
+    // 0 should return -1
+    got = a.Forward(0)
+    if got != -1 {
+        t.Errorf("Sign{}.Forward(%v) == %v, want %v", 0, got, -1)
+    }
+
+    // -0.01 should return -1
+    got = a.Forward(-0.01)
+    if got != -1 {
+        t.Errorf("Sign{}.Forward(%v) == %v, want %v", -0.01, got, -1)
+    }
+
+    // 0.01 should return 1
+    got = a.Forward(0.01)
+    if got != 1 {
+        t.Errorf("Sign{}.Forward(%v) == %v, want %v", 0.01, got, 1)
+    }
+}
+
+func TestForward(t *testing.T) {
+    tests := []struct {
+        a Activation
+        x float64
+        want float64
+    }{
+        {Identity{}, -3.5, -3.5},
+        {Sigmoid{}, 0, 0.5},
+        {Tanh{}, 0, 0},
+        {ReLU{}, -2, 0},
+        {ReLU{}, 2, 2},
+        {LeakyReLU{Alpha: 0.1}, -2, -0.2},
+        {LeakyReLU{Alpha: 0.1}, 2, 2},
+        {ELU{Alpha: 1}, 2, 2},
+        {ELU{Alpha: 1}, math.Inf(-1), -1},
+        {Softplus{}, 0, math.Log(2)},
+        {Softplus{}, 1000, 1000},
+    }
+
+    for _, test := range tests {
+        got := test.a.Forward(test.x)
+        if math.Abs(got - test.want) > 1e-12 {
+            t.Errorf("%T.Forward(%v) == %v, want %v", test.a, test.x, got, test.want)
+        }
+    }
+}
+
+func TestDerivative(t *testing.T) {
+    activations := []Activation{
+        Identity{},
+        Sigmoid{},
+        Tanh{},
+        ReLU{},
+        LeakyReLU{Alpha: 0.1},
+        ELU{Alpha: 1},
+        Softplus{},
+    }
+    const h float64 = 1e-6
+
+    // Stay clear of 0, where ReLU and friends have a kink.
+    for _, a := range activations {
+        for _, x := range []float64{-2, -0.5, 0.5, 2} {
+            want := (a.Forward(x + h) - a.Forward(x - h)) / (2 * h)
+            got := a.Derivative(x)
+            if math.Abs(got - want) > 1e-6 {
+                t.Errorf("%T.Derivative(%v) == %v, want %v", a, x, got, want)
+            }
+        }
+    }
+}
+
+func TestSoftmax(t *testing.T) {
+    x := []float64{1, 2, 3}
+    got := ForwardAll(Softmax{}, x)
+
+    var sum float64 = 0
+    for i := 0; i < len(got); i++ {
+        sum = sum + got[i]
+    }
+    if math.Abs(sum - 1) > 1e-12 {
+        t.Errorf("ForwardAll(Softmax{}, %v) == %v, should add up to 1", x, got)
+    }
+    if !(got[0] < got[1] && got[1] < got[2]) {
+        t.Errorf("ForwardAll(Softmax{}, %v) == %v, should keep the order of the sums", x, got)
+    }
+
+    // Huge sums should not overflow.
+    got = ForwardAll(Softmax{}, []float64{1000, 1000})
+    if got[0] != 0.5 || got[1] != 0.5 {
+        t.Errorf("ForwardAll(Softmax{}, {1000, 1000}) == %v, want {0.5, 0.5}", got)
+    }
+
+    // The backward pass should match a numeric estimate of the gradient of
+    // sum(gradient[i] * softmax(x)[i]).
+    gradient := []float64{0.5, -1, 2}
+    backward := BackwardAll(Softmax{}, x, gradient)
+    const h float64 = 1e-6
+    for i := 0; i < len(x); i++ {
+        up := append([]float64{}, x...)
+        down := append([]float64{}, x...)
+        up[i] = up[i] + h
+        down[i] = down[i] - h
+        var want float64 = 0
+        su := ForwardAll(Softmax{}, up)
+        sd := ForwardAll(Softmax{}, down)
+        for j := 0; j < len(x); j++ {
+            want = want + (gradient[j] * (su[j] - sd[j]) / (2 * h))
+        }
+        if math.Abs(backward[i] - want) > 1e-6 {
+            t.Errorf("BackwardAll(Softmax{}, %v, %v)[%v] == %v, want %v", x, gradient, i, backward[i], want)
+        }
+    }
+}
+
+func TestBackwardAll(t *testing.T) {
+    x := []float64{-1, 2}
+    gradient := []float64{3, 4}
+    got := BackwardAll(ReLU{}, x, gradient)
+    if got[0] != 0 || got[1] != 4 {
+        t.Errorf("BackwardAll(ReLU{}, %v, %v) == %v, want %v", x, gradient, got, []float64{0, 4})
+    }
+}
+
+func TestSpec(t *testing.T) {
+    activations := []Activation{
+        Step{Threshold: 0.5},
+        Sign{},
+        Identity{},
+        Sigmoid{},
+        Tanh{},
+        ReLU{},
+        LeakyReLU{Alpha: 0.1},
+        ELU{Alpha: 2},
+        Softplus{},
+        Softmax{},
+    }
+
+    for _, a := range activations {
+        spec, err := Describe(a)
+        if err != nil {
+            t.Errorf("Describe(%T) returned %v", a, err)
+            continue
+        }
+        got, err := FromSpec(spec)
+        if err != nil || got != a {
+            t.Errorf("FromSpec(%v) == %v, %v, want %v", spec, got, err, a)
+        }
+    }
+
+    if _, err := FromSpec(Spec{Name: "nope"}); err == nil {
+        t.Errorf("FromSpec should reject an unknown activation")
+    }
+}
@@ -0,0 +1,159 @@
+package dataset
+
+import (
+    "fmt"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+var (
+    ErrInvalidRange = fmt.Errorf("dataset: range must be within the samples, with start no later than end")
+    ErrInvalidBatchSize = fmt.Errorf("dataset: batch size must be above 0")
+    ErrInvalidFraction = fmt.Errorf("dataset: fractions must not be negative and must add up to at most 1")
+)
+
+/**
+ * An input and the answer a model should give for it.
+ */
+type Sample struct {
+    Input []float64
+    Desired []float64
+}
+
+/**
+ * A collection of samples that can be read in any order.
+ */
+type Dataset interface {
+    Len () int
+    At (i int) Sample
+}
+
+/**
+ * A dataset held entirely in memory.
+ */
+type InMemory struct {
+    samples []Sample
+}
+
+func (d *InMemory) Len () int {
+    return len(d.samples)
+}
+
+func (d *InMemory) At (i int) Sample {
+    return d.samples[i]
+}
+
+/**
+ * Add a sample to the end of the dataset.
+ */
+func (d *InMemory) Add (input, desired []float64) {
+    d.samples = append(d.samples, Sample{Input: input, Desired: desired})
+}
+
+/**
+ * Create an in-memory dataset, optionally starting with some samples.
+ */
+func InMemoryFactory (samples ...Sample) *InMemory {
+    d := &InMemory{
+        samples: samples,
+    }
+    return d
+}
+
+/**
+ * Some of another dataset's samples, in a given order. Nothing is copied.
+ */
+type view struct {
+    d Dataset
+    indices []int
+}
+
+func (v view) Len () int {
+    return len(v.indices)
+}
+
+func (v view) At (i int) Sample {
+    return v.d.At(v.indices[i])
+}
+
+/**
+ * The samples from start up to, but not including, end.
+ *
+ * ErrInvalidRange is returned unless 0 <= start <= end <= d.Len().
+ */
+func Slice (d Dataset, start, end int) (Dataset, error) {
+    if (start < 0 || end < start || end > d.Len()) {
+        return nil, ErrInvalidRange
+    }
+    indices := make([]int, end - start)
+    for i := 0; i < len(indices); i++ {
+        indices[i] = start + i
+    }
+    return view{d: d, indices: indices}, nil
+}
+
+/**
+ * The same samples in a random order. A source with the same seed always
+ * gives the same order.
+ */
+func Shuffle (d Dataset, src *random.Source) Dataset {
+    return view{d: d, indices: src.Perm(d.Len())}
+}
+
+/**
+ * Split the samples into consecutive batches of the given size. The last
+ * batch is smaller when the samples do not divide evenly.
+ *
+ * ErrInvalidBatchSize is returned when size is not above 0.
+ */
+func Batches (d Dataset, size int) ([]Dataset, error) {
+    if (size <= 0) {
+        return nil, ErrInvalidBatchSize
+    }
+    var batches []Dataset
+    for start := 0; start < d.Len(); start = start + size {
+        end := start + size
+        if (end > d.Len()) {
+            end = d.Len()
+        }
+        batch, _ := Slice(d, start, end)
+        batches = append(batches, batch)
+    }
+    return batches, nil
+}
+
+/**
+ * Split the samples into training, validation and test sets.
+ *
+ * train and validation are the fractions of samples, e.g. 0.8 and 0.1, that go
+ * into the first two sets; whatever is left is the test set. Shuffle first if
+ * the samples are in any kind of order.
+ *
+ * ErrInvalidFraction is returned when either fraction is negative or they add
+ * up to more than 1.
+ */
+func Split (d Dataset, train, validation float64) (Dataset, Dataset, Dataset, error) {
+    if (!(train >= 0) || !(validation >= 0) || train + validation > 1) {
+        return nil, nil, nil, ErrInvalidFraction
+    }
+    n := d.Len()
+    a := int(float64(n) * train)
+    b := a + int(float64(n) * validation)
+    if (b > n) {
+        b = n
+    }
+    first, _ := Slice(d, 0, a)
+    second, _ := Slice(d, a, b)
+    third, _ := Slice(d, b, n)
+    return first, second, third, nil
+}
+
+/**
+ * Copy every sample of a dataset into a slice.
+ */
+func Samples (d Dataset) []Sample {
+    samples := make([]Sample, d.Len())
+    for i := 0; i < len(samples); i++ {
+        samples[i] = d.At(i)
+    }
+    return samples
+}
@@ -0,0 +1,136 @@
+package dataset
+
+import (
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+/**
+ * A dataset of n samples whose input is their original position.
+ */
+func numbered(n int) *InMemory {
+    d := InMemoryFactory()
+    for i := 0; i < n; i++ {
+        d.Add([]float64{float64(i)}, []float64{float64(i % 2)})
+    }
+    return d
+}
+
+/**
+ * The original positions of a dataset's samples, in its order.
+ */
+func positions(d Dataset) []int {
+    p := make([]int, d.Len())
+    for i := 0; i < len(p); i++ {
+        p[i] = int(d.At(i).Input[0])
+    }
+    return p
+}
+
+func TestInMemory(t *testing.T) {
+    d := InMemoryFactory(Sample{Input: []float64{1, 2}, Desired: []float64{3}})
+    d.Add([]float64{4, 5}, []float64{6})
+
+    if d.Len() != 2 {
+        t.Errorf("d.Len() == %v, want %v", d.Len(), 2)
+    }
+    if d.At(1).Input[1] != 5 || d.At(1).Desired[0] != 6 {
+        t.Errorf("d.At(1) == %v, want {[4 5] [6]}", d.At(1))
+    }
+}
+
+func TestShuffle(t *testing.T) {
+    d := numbered(20)
+
+    a := positions(Shuffle(d, random.SourceFactory(42)))
+    b := positions(Shuffle(d, random.SourceFactory(42)))
+    c := positions(Shuffle(d, random.SourceFactory(7)))
+
+    seen := make(map[int]bool)
+    var same, moved bool = true, false
+    for i := 0; i < len(a); i++ {
+        seen[a[i]] = true
+        if a[i] != b[i] {
+            same = false
+        }
+        if a[i] != c[i] {
+            moved = true
+        }
+    }
+
+    if !same {
+        t.Errorf("Shuffling with the same seed should give the same order, but gave %v and %v", a, b)
+    }
+    if !moved {
+        t.Errorf("Shuffling with different seeds should give different orders, but both gave %v", a)
+    }
+    if len(seen) != 20 {
+        t.Errorf("Shuffling should keep every sample, but gave %v", a)
+    }
+}
+
+func TestBatches(t *testing.T) {
+    batches, err := Batches(numbered(10), 4)
+
+    if err != nil {
+        t.Fatalf("Batches(10 samples, 4) returned %v", err)
+    }
+    if len(batches) != 3 {
+        t.Fatalf("Batches(10 samples, 4) gave %v batches, want %v", len(batches), 3)
+    }
+    sizes := []int{4, 4, 2}
+    for i := 0; i < len(sizes); i++ {
+        if batches[i].Len() != sizes[i] {
+            t.Errorf("Batch %v has %v samples, want %v", i, batches[i].Len(), sizes[i])
+        }
+    }
+    if batches[2].At(1).Input[0] != 9 {
+        t.Errorf("The last sample of the last batch should be 9, but is %v", batches[2].At(1).Input[0])
+    }
+}
+
+func TestSplit(t *testing.T) {
+    train, validation, test, err := Split(numbered(10), 0.6, 0.3)
+
+    if err != nil {
+        t.Fatalf("Split(10 samples, 0.6, 0.3) returned %v", err)
+    }
+    if train.Len() != 6 || validation.Len() != 3 || test.Len() != 1 {
+        t.Errorf("Split(10 samples, 0.6, 0.3) gave %v, %v and %v samples, want 6, 3 and 1", train.Len(), validation.Len(), test.Len())
+    }
+    if validation.At(0).Input[0] != 6 || test.At(0).Input[0] != 9 {
+        t.Errorf("Split should keep the samples in order, but gave %v, %v and %v", positions(train), positions(validation), positions(test))
+    }
+}
+
+func TestInvalidRanges(t *testing.T) {
+    ranges := [][]int{{-1, 2}, {3, 1}, {2, 6}}
+    for _, r := range ranges {
+        if _, err := Slice(numbered(5), r[0], r[1]); err != ErrInvalidRange {
+            t.Errorf("Slice(5 samples, %v, %v) returned %v, want %v", r[0], r[1], err, ErrInvalidRange)
+        }
+    }
+    for _, size := range []int{0, -4} {
+        if _, err := Batches(numbered(10), size); err != ErrInvalidBatchSize {
+            t.Errorf("Batches(10 samples, %v) returned %v, want %v", size, err, ErrInvalidBatchSize)
+        }
+    }
+    fractions := [][]float64{{-0.1, 0.5}, {0.5, -0.1}, {0.8, 0.3}, {math.NaN(), 0.2}}
+    for _, f := range fractions {
+        if _, _, _, err := Split(numbered(10), f[0], f[1]); err != ErrInvalidFraction {
+            t.Errorf("Split(10 samples, %v, %v) returned %v, want %v", f[0], f[1], err, ErrInvalidFraction)
+        }
+    }
+}
+
+func TestSamples(t *testing.T) {
+    slice, err := Slice(numbered(5), 1, 3)
+    if err != nil {
+        t.Fatalf("Slice(5 samples, 1, 3) returned %v", err)
+    }
+    got := Samples(slice)
+    if len(got) != 2 || got[0].Input[0] != 1 || got[1].Input[0] != 2 {
+        t.Errorf("Samples(Slice(5 samples, 1, 3)) == %v, want samples 1 and 2", got)
+    }
+}
@@ -0,0 +1,314 @@
+package dataset
+
+import (
+    "bufio"
+    "encoding/csv"
+    "encoding/json"
+    "fmt"
+    "io"
+    "strconv"
+    "strings"
+)
+
+/**
+ * Describes how the columns of a file become samples.
+ *
+ * Features and Labels name the columns that make up each sample's input and
+ * desired output, in order. A column listed in Categories holds one of the
+ * given categories and becomes one input per category, 1 for the category it
+ * holds and 0 for the others.
+ *
+ * A value is missing when it is empty, a JSON null or one of Missing, e.g.
+ * "NA" or "null". A missing value takes its column's entry in Defaults;
+ * without one the line is skipped when SkipMissing is set and is an error
+ * otherwise.
+ *
+ * CSV files are expected to start with a header naming the columns, unless
+ * Columns names them instead.
+ */
+type Schema struct {
+    Features []string
+    Labels []string
+    Categories map[string][]string
+    Defaults map[string]float64
+    Missing []string
+    SkipMissing bool
+    Columns []string
+}
+
+/**
+ * A problem with one value in a file.
+ *
+ * Line is 0 when the problem is with a column as a whole, e.g. one named by
+ * a Schema that no line of a JSON Lines file has, rather than with any one
+ * line.
+ */
+type ParseError struct {
+    Line int
+    Column string
+    Value string
+    Err error
+}
+
+func (e *ParseError) Error () string {
+    if (e.Line == 0) {
+        return fmt.Sprintf("dataset: column %q: %v", e.Column, e.Err)
+    }
+    if (e.Column == "") {
+        return fmt.Sprintf("dataset: line %v: %v", e.Line, e.Err)
+    }
+    if (e.Err == ErrNoColumn) {
+        return fmt.Sprintf("dataset: line %v, column %q: %v", e.Line, e.Column, e.Err)
+    }
+    return fmt.Sprintf("dataset: line %v, column %q, value %q: %v", e.Line, e.Column, e.Value, e.Err)
+}
+
+func (e *ParseError) Unwrap () error {
+    return e.Err
+}
+
+/**
+ * Reasons a value could not be used.
+ */
+var (
+    ErrMissing = fmt.Errorf("value is missing")
+    ErrUnknownCategory = fmt.Errorf("unknown category")
+    ErrNoColumn = fmt.Errorf("no such column")
+    ErrNoHeader = fmt.Errorf("header is missing")
+    ErrTrailingData = fmt.Errorf("unexpected data after the JSON object")
+)
+
+/**
+ * Load samples from CSV.
+ */
+func LoadCSV (r io.Reader, s Schema) (*InMemory, error) {
+    reader := csv.NewReader(r)
+    reader.FieldsPerRecord = -1
+
+    columns := s.Columns
+    var header int = 0
+    if (columns == nil) {
+        record, err := reader.Read()
+        if (err == io.EOF) {
+            return nil, &ParseError{Line: 1, Err: ErrNoHeader}
+        }
+        if err != nil {
+            return nil, err
+        }
+        columns = record
+        header = 1
+    }
+    positions := make(map[string]int)
+    for i := 0; i < len(columns); i++ {
+        positions[strings.TrimSpace(columns[i])] = i
+    }
+    if err := s.check(header, func (name string) bool { _, ok := positions[name]; return ok }); err != nil {
+        return nil, err
+    }
+
+    d := InMemoryFactory()
+    for {
+        record, err := reader.Read()
+        if (err == io.EOF) {
+            break
+        }
+        if err != nil {
+            return nil, err
+        }
+        line, _ := reader.FieldPos(0)
+        if (len(record) != len(columns)) {
+            return nil, &ParseError{Line: line, Err: fmt.Errorf("has %v values, want %v", len(record), len(columns))}
+        }
+
+        err = s.add(d, line, func (name string) string {
+            return strings.TrimSpace(record[positions[name]])
+        })
+        if err != nil {
+            return nil, err
+        }
+    }
+    return d, nil
+}
+
+/**
+ * Load samples from JSON Lines, i.e. one JSON object per line. Blank lines
+ * are ignored and a key that is left out counts as a missing value, but every
+ * column the schema uses must be a key on at least one line.
+ */
+func LoadJSONL (r io.Reader, s Schema) (*InMemory, error) {
+    scanner := bufio.NewScanner(r)
+    scanner.Buffer(make([]byte, 64 * 1024), 16 * 1024 * 1024)
+
+    var objects []map[string]interface{}
+    var lines []int
+    keys := make(map[string]bool)
+    var line int = 0
+    for scanner.Scan() {
+        line++
+        text := strings.TrimSpace(scanner.Text())
+        if (text == "") {
+            continue
+        }
+
+        var object map[string]interface{}
+        decoder := json.NewDecoder(strings.NewReader(text))
+        decoder.UseNumber()
+        if err := decoder.Decode(&object); err != nil {
+            return nil, &ParseError{Line: line, Err: err}
+        }
+        if _, err := decoder.Token(); err != io.EOF {
+            return nil, &ParseError{Line: line, Err: ErrTrailingData}
+        }
+        for key := range object {
+            keys[key] = true
+        }
+        objects = append(objects, object)
+        lines = append(lines, line)
+    }
+    if err := scanner.Err(); err != nil {
+        return nil, err
+    }
+    if err := s.check(0, func (name string) bool { return keys[name] }); err != nil {
+        return nil, err
+    }
+
+    d := InMemoryFactory()
+    for i, object := range objects {
+        err := s.add(d, lines[i], func (name string) string {
+            switch v := object[name].(type) {
+            case nil:
+                return ""
+            case json.Number:
+                return v.String()
+            case string:
+                return strings.TrimSpace(v)
+            case bool:
+                if (v) {
+                    return "1"
+                }
+                return "0"
+            default:
+                return fmt.Sprint(v)
+            }
+        })
+        if err != nil {
+            return nil, err
+        }
+    }
+    return d, nil
+}
+
+/**
+ * Make sure every column the schema uses can be found. line is where the
+ * columns were named, or 0 when they were not named on any one line.
+ */
+func (s Schema) check (line int, has func (name string) bool) error {
+    for _, names := range [][]string{s.Features, s.Labels} {
+        for _, name := range names {
+            if (!has(name)) {
+                return &ParseError{Line: line, Column: name, Err: ErrNoColumn}
+            }
+        }
+    }
+    return nil
+}
+
+/**
+ * Turn one line's values into a sample and add it, unless it is to be
+ * skipped for a missing value.
+ */
+func (s Schema) add (d *InMemory, line int, get func (name string) string) error {
+    input, err := s.values(line, s.Features, get)
+    if (err == nil) {
+        var desired []float64
+        desired, err = s.values(line, s.Labels, get)
+        if (err == nil) {
+            d.Add(input, desired)
+            return nil
+        }
+    }
+
+    if e, ok := err.(*ParseError); ok && e.Err == ErrMissing && s.SkipMissing {
+        return nil
+    }
+    return err
+}
+
+/**
+ * Parse the values of the given columns.
+ */
+func (s Schema) values (line int, names []string, get func (name string) string) ([]float64, error) {
+    var values []float64
+    for _, name := range names {
+        text := get(name)
+
+        if (s.missing(text)) {
+            if (!s.hasDefault(name)) {
+                return nil, &ParseError{Line: line, Column: name, Value: text, Err: ErrMissing}
+            }
+            if categories, ok := s.Categories[name]; ok {
+                // A default for a categorical column is the category's index.
+                values = append(values, oneHot(len(categories), int(s.Defaults[name]))...)
+            } else {
+                values = append(values, s.Defaults[name])
+            }
+            continue
+        }
+
+        if categories, ok := s.Categories[name]; ok {
+            index := -1
+            for i := 0; i < len(categories); i++ {
+                if (categories[i] == text) {
+                    index = i
+                    break
+                }
+            }
+            if (index < 0) {
+                return nil, &ParseError{Line: line, Column: name, Value: text, Err: ErrUnknownCategory}
+            }
+            values = append(values, oneHot(len(categories), index)...)
+            continue
+        }
+
+        value, err := strconv.ParseFloat(text, 64)
+        if err != nil {
+            return nil, &ParseError{Line: line, Column: name, Value: text, Err: err.(*strconv.NumError).Err}
+        }
+        values = append(values, value)
+    }
+    return values, nil
+}
+
+/**
+ * Whether a value counts as missing.
+ */
+func (s Schema) missing (text string) bool {
+    if (text == "") {
+        return true
+    }
+    for _, m := range s.Missing {
+        if (text == m) {
+            return true
+        }
+    }
+    return false
+}
+
+/**
+ * Whether a column has a default for missing values.
+ */
+func (s Schema) hasDefault (name string) bool {
+    _, ok := s.Defaults[name]
+    return ok
+}
+
+/**
+ * n values of 0, except for a 1 at index.
+ */
+func oneHot (n, index int) []float64 {
+    values := make([]float64, n)
+    if (index >= 0 && index < n) {
+        values[index] = 1
+    }
+    return values
+}
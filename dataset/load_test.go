@@ -0,0 +1,218 @@
+package dataset
+
+import (
+    "errors"
+    "strings"
+    "testing"
+)
+
+func TestLoadCSV(t *testing.T) {
+    file := "x, y, colour, label\n" +
+        "1, 2, red, 1\n" +
+        "3, NA, blue, 0\n" +
+        "5, 6, green, 1\n"
+    schema := Schema{
+        Features: []string{"y", "colour", "x"},
+        Labels: []string{"label"},
+        Categories: map[string][]string{"colour": {"red", "green", "blue"}},
+        Defaults: map[string]float64{"y": -1},
+        Missing: []string{"NA"},
+    }
+
+    d, err := LoadCSV(strings.NewReader(file), schema)
+    if err != nil {
+        t.Fatalf("LoadCSV() returned %v", err)
+    }
+    if d.Len() != 3 {
+        t.Fatalf("LoadCSV() loaded %v samples, want %v", d.Len(), 3)
+    }
+
+    want := [][]float64{{2, 1, 0, 0, 1}, {-1, 0, 0, 1, 3}, {6, 0, 1, 0, 5}}
+    for i := 0; i < len(want); i++ {
+        got := d.At(i).Input
+        for j := 0; j < len(want[i]); j++ {
+            if len(got) != len(want[i]) || got[j] != want[i][j] {
+                t.Errorf("Sample %v input == %v, want %v", i, got, want[i])
+                break
+            }
+        }
+    }
+    if d.At(1).Desired[0] != 0 {
+        t.Errorf("Sample 1 desired == %v, want %v", d.At(1).Desired, []float64{0})
+    }
+}
+
+func TestLoadCSVColumns(t *testing.T) {
+    schema := Schema{
+        Features: []string{"a"},
+        Labels: []string{"b"},
+        Columns: []string{"a", "b"},
+    }
+
+    d, err := LoadCSV(strings.NewReader("1,2\n3,4\n"), schema)
+    if err != nil {
+        t.Fatalf("LoadCSV() returned %v", err)
+    }
+    if d.Len() != 2 || d.At(0).Input[0] != 1 || d.At(1).Desired[0] != 4 {
+        t.Errorf("LoadCSV() without a header loaded %v", Samples(d))
+    }
+
+    // Without a header, line 1 is the first sample and a column missing
+    // from Columns is not on any line.
+    _, err = LoadCSV(strings.NewReader("1,2\n,4\n"), schema)
+    var e *ParseError
+    if !errors.As(err, &e) || e.Line != 2 {
+        t.Errorf("LoadCSV() of a missing value on line 2 returned %v", err)
+    }
+    schema.Columns = []string{"a", "c"}
+    _, err = LoadCSV(strings.NewReader("1,2\n"), schema)
+    if !errors.As(err, &e) || e.Line != 0 || !errors.Is(err, ErrNoColumn) {
+        t.Errorf("LoadCSV() with no column b returned %v, want line 0 and %v", err, ErrNoColumn)
+    }
+}
+
+func TestLoadCSVSkipMissing(t *testing.T) {
+    schema := Schema{
+        Features: []string{"a"},
+        Labels: []string{"b"},
+        SkipMissing: true,
+    }
+
+    d, err := LoadCSV(strings.NewReader("a,b\n1,\n3,4\n"), schema)
+    if err != nil {
+        t.Fatalf("LoadCSV() returned %v", err)
+    }
+    if d.Len() != 1 || d.At(0).Input[0] != 3 {
+        t.Errorf("LoadCSV() should have skipped the line with a missing value, but loaded %v", Samples(d))
+    }
+}
+
+func TestLoadCSVErrors(t *testing.T) {
+    schema := Schema{
+        Features: []string{"a"},
+        Labels: []string{"b"},
+        Categories: map[string][]string{"b": {"yes", "no"}},
+    }
+
+    tests := []struct {
+        file string
+        line int
+        column string
+        err error
+    }{
+        {"a,b\n1,yes\nx,no\n", 3, "a", nil},
+        {"a,b\n1,yes\n2,maybe\n", 3, "b", ErrUnknownCategory},
+        {"a,b\n,yes\n", 2, "a", ErrMissing},
+        {"a,c\n1,yes\n", 1, "b", ErrNoColumn},
+        {"a,b\n1,yes\n2\n", 3, "", nil},
+        {"", 1, "", ErrNoHeader},
+    }
+
+    for _, test := range tests {
+        _, err := LoadCSV(strings.NewReader(test.file), schema)
+        var e *ParseError
+        if !errors.As(err, &e) {
+            t.Errorf("LoadCSV(%q) returned %v, want a *ParseError", test.file, err)
+            continue
+        }
+        if e.Line != test.line || e.Column != test.column {
+            t.Errorf("LoadCSV(%q) failed at line %v, column %q, want line %v, column %q", test.file, e.Line, e.Column, test.line, test.column)
+        }
+        if test.err != nil && !errors.Is(err, test.err) {
+            t.Errorf("LoadCSV(%q) returned %v, want %v", test.file, err, test.err)
+        }
+    }
+}
+
+func TestLoadJSONL(t *testing.T) {
+    file := `{"x": 1, "on": true, "kind": "a", "label": 1}` + "\n" +
+        "\n" +
+        `{"x": "2.5", "on": false, "kind": "b", "label": 0}` + "\n" +
+        `{"on": true, "kind": "a", "label": 0}` + "\n"
+    schema := Schema{
+        Features: []string{"x", "on", "kind"},
+        Labels: []string{"label"},
+        Categories: map[string][]string{"kind": {"a", "b"}},
+        Defaults: map[string]float64{"x": 0},
+    }
+
+    d, err := LoadJSONL(strings.NewReader(file), schema)
+    if err != nil {
+        t.Fatalf("LoadJSONL() returned %v", err)
+    }
+
+    want := [][]float64{{1, 1, 1, 0}, {2.5, 0, 0, 1}, {0, 1, 1, 0}}
+    if d.Len() != len(want) {
+        t.Fatalf("LoadJSONL() loaded %v samples, want %v", d.Len(), len(want))
+    }
+    for i := 0; i < len(want); i++ {
+        got := d.At(i).Input
+        for j := 0; j < len(want[i]); j++ {
+            if len(got) != len(want[i]) || got[j] != want[i][j] {
+                t.Errorf("Sample %v input == %v, want %v", i, got, want[i])
+                break
+            }
+        }
+    }
+}
+
+func TestLoadJSONLErrors(t *testing.T) {
+    schema := Schema{
+        Features: []string{"x"},
+        Labels: []string{"y"},
+    }
+
+    tests := []struct {
+        file string
+        line int
+        column string
+        err error
+    }{
+        {`{"x": 1, "y": 1}` + "\n" + `{"x": 1, "y": 1` + "\n", 2, "", nil},
+        {`{"x": 1, "y": 1}` + "\n\n" + `{"x": "one", "y": 1}` + "\n", 3, "x", nil},
+        {`{"x": 1}` + "\n" + `{"x": 1, "y": 1}` + "\n", 1, "y", ErrMissing},
+        {`{"x": 1}` + "\n", 0, "y", ErrNoColumn},
+        {`{"x": 1, "y": 1} garbage` + "\n", 1, "", ErrTrailingData},
+        {`{"x": 1, "y": 1}{"x": 2}` + "\n", 1, "", ErrTrailingData},
+    }
+
+    for _, test := range tests {
+        _, err := LoadJSONL(strings.NewReader(test.file), schema)
+        var e *ParseError
+        if !errors.As(err, &e) {
+            t.Errorf("LoadJSONL(%q) returned %v, want a *ParseError", test.file, err)
+            continue
+        }
+        if e.Line != test.line || e.Column != test.column {
+            t.Errorf("LoadJSONL(%q) failed at line %v, column %q, want line %v, column %q", test.file, e.Line, e.Column, test.line, test.column)
+        }
+        if test.err != nil && !errors.Is(err, test.err) {
+            t.Errorf("LoadJSONL(%q) returned %v, want %v", test.file, err, test.err)
+        }
+    }
+
+    // A misspelled column is an error even when missing values are skipped.
+    schema.SkipMissing = true
+    schema.Labels = []string{"why"}
+    if _, err := LoadJSONL(strings.NewReader(`{"x": 1, "y": 1}` + "\n"), schema); !errors.Is(err, ErrNoColumn) {
+        t.Errorf("LoadJSONL() with no column why returned %v, want %v", err, ErrNoColumn)
+    }
+}
+
+func TestParseError(t *testing.T) {
+    tests := []struct {
+        err *ParseError
+        want string
+    }{
+        {&ParseError{Line: 2, Column: "a", Value: "x", Err: ErrUnknownCategory}, `dataset: line 2, column "a", value "x": unknown category`},
+        {&ParseError{Line: 1, Column: "c", Err: ErrNoColumn}, `dataset: line 1, column "c": no such column`},
+        {&ParseError{Column: "c", Err: ErrNoColumn}, `dataset: column "c": no such column`},
+        {&ParseError{Line: 1, Err: ErrNoHeader}, `dataset: line 1: header is missing`},
+    }
+
+    for _, test := range tests {
+        if got := test.err.Error(); got != test.want {
+            t.Errorf("Error() == %q, want %q", got, test.want)
+        }
+    }
+}
@@ -0,0 +1,53 @@
+/**
+ * A Multi-Layer Neural Network Example Learning XOR.
+ *
+ * A single Perceptron can only learn functions whose answers can be split by
+ * a straight line, which XOR cannot. Adding a hidden layer and training with
+ * backpropagation lets the network learn it.
+ *
+ * Source: http://natureofcode.com/book/chapter-10-neural-networks/
+ */
+package main
+
+import (
+    "fmt"
+    "log"
+    "github.com/josephdpurcell/go-neural-network/network"
+)
+
+func main() {
+    inputs := [][]float64{{0, 0}, {0, 1}, {1, 0}, {1, 1}}
+    answers := [][]float64{{0}, {1}, {1}, {0}}
+
+    // Two inputs, a hidden layer of four neurons and one output.
+    n, err := network.NetworkFactory([]int{2, 4, 1}, 0.5, nil)
+    if err != nil {
+        log.Fatal(err)
+    }
+
+    // Train our Network.
+    for epoch := 0; epoch < 10000; epoch++ {
+        var loss float64 = 0
+        for i := 0; i < len(inputs); i++ {
+            value, err := n.Train(inputs[i], answers[i])
+            if err != nil {
+                log.Fatal(err)
+            }
+            loss = loss + value
+        }
+        if (epoch % 1000 == 0) {
+            fmt.Printf("%v: loss %v", epoch, loss)
+            fmt.Println()
+        }
+    }
+
+    // Show what it learned.
+    for i := 0; i < len(inputs); i++ {
+        output, err := n.Feedforward(inputs[i])
+        if err != nil {
+            log.Fatal(err)
+        }
+        fmt.Printf("%v => %v", inputs[i], output)
+        fmt.Println()
+    }
+}
@@ -10,8 +10,15 @@ package main
 
 import (
     "fmt"
+    "log"
+    "os"
+    "github.com/josephdpurcell/go-neural-network/activation"
+    "github.com/josephdpurcell/go-neural-network/dataset"
+    "github.com/josephdpurcell/go-neural-network/event"
+    "github.com/josephdpurcell/go-neural-network/metrics"
+    "github.com/josephdpurcell/go-neural-network/perceptron"
     "github.com/josephdpurcell/go-neural-network/random"
-    "github.com/josephdpurcell/go-neural-network/perceptronFofX"
+    "github.com/josephdpurcell/go-neural-network/trainer"
 )
 
 /**
@@ -22,25 +29,17 @@ func f(x float64) float64 {
 }
 
 /**
- * A trainer has a point (the input) and the answer (whether or not it is above
- * the line).
+ * Create a sample from a random point (the input) and the answer (whether or
+ * not it is above the line).
  */
-type Trainer struct {
-    input []float64
-    answer float64
-}
-
-/**
- * Create a Trainer based on a point.
- */
-func TrainerFactory () Trainer {
+func point (src *random.Source) dataset.Sample {
     var xmin float64 = -400
     var xmax float64 = 400
     var ymin float64 = -100
     var ymax float64 = 100
     var answer float64
-    var x float64 = random.Random(xmin, xmax)
-    var y float64 = random.Random(ymin, ymax)
+    var x float64 = src.Random(xmin, xmax)
+    var y float64 = src.Random(ymin, ymax)
 
     if (y < f(x)) {
         answer = -1
@@ -48,31 +47,82 @@ func TrainerFactory () Trainer {
         answer = 1
     }
 
-    var input = []float64{x, y, 1}
-    t := Trainer{
-        input: input,
-        answer: answer,
+    s := dataset.Sample{
+        Input: []float64{x, y, 1},
+        Desired: []float64{answer},
     }
 
-    return t
+    return s
 }
 
 func main() {
-    // Setup the trainers.
+    // Use the same random numbers every run so runs can be compared.
+    src := random.SourceFactory(1)
+
+    // Setup the samples.
     const count int = 100000
-    var trainers [count]Trainer
-    for i := 0; i < len(trainers); i++ {
-        trainers[i] = TrainerFactory()
+    samples := dataset.InMemoryFactory()
+    for i := 0; i < count; i++ {
+        s := point(src)
+        samples.Add(s.Input, s.Desired)
     }
 
     // Learning Constant is low b/c it's fun to watch, not necessarily for performance.
-    p := perceptronFofX.PerceptronFactory(3, 0.00001)
-
-    // Train our Perceptron.
-    for i := 0; i < len(trainers); i++ {
-        fmt.Printf("%v: ", i)
-        p.Train(trainers[i].input, trainers[i].answer)
+    p, err := perceptron.RandomPerceptronFactory(3, 0.00001, activation.Sign{}, src)
+    if err != nil {
+        log.Fatal(err)
     }
-}
+    p.SetObserver(event.Printer(os.Stdout))
 
+    // Train our Perceptron, going through the samples once.
+    t := trainer.TrainerFactory(trainer.PerceptronModel(&p), trainer.Config{
+        Epochs: 1,
+        Observer: event.Printer(os.Stdout),
+    })
+    history, err := t.Run(samples)
+    if err != nil {
+        log.Fatal(err)
+    }
+    fmt.Printf("Stopped with %v mistakes out of %v", history.Epochs[0].Mistakes, count)
+    fmt.Println()
 
+    // Measure how well it does on points it has not seen.
+    var inputs [][]float64
+    var actual []float64
+    for i := 0; i < 1000; i++ {
+        s := point(src)
+        inputs = append(inputs, s.Input)
+        actual = append(actual, s.Desired[0])
+    }
+    predicted, err := p.FeedforwardBatch(inputs)
+    if err != nil {
+        log.Fatal(err)
+    }
+    scores, err := p.ScoreBatch(inputs)
+    if err != nil {
+        log.Fatal(err)
+    }
+    accuracy, err := metrics.Accuracy(predicted, actual)
+    if err != nil {
+        log.Fatal(err)
+    }
+    f1, err := metrics.F1(predicted, actual, 1)
+    if err != nil {
+        log.Fatal(err)
+    }
+    roc, err := metrics.ROC(scores, actual, 1)
+    if err != nil {
+        log.Fatal(err)
+    }
+    confusion, err := metrics.ConfusionMatrixFactory(predicted, actual)
+    if err != nil {
+        log.Fatal(err)
+    }
+    fmt.Printf("Accuracy: %v", accuracy)
+    fmt.Println()
+    fmt.Printf("F1: %v", f1)
+    fmt.Println()
+    fmt.Printf("AUC: %v", metrics.AUC(roc))
+    fmt.Println()
+    fmt.Print(confusion)
+}
@@ -10,6 +10,10 @@ package main
 
 import (
     "fmt"
+    "log"
+    "os"
+    "github.com/josephdpurcell/go-neural-network/event"
+    "github.com/josephdpurcell/go-neural-network/forces"
     "github.com/josephdpurcell/go-neural-network/mover"
     "github.com/josephdpurcell/go-neural-network/pvector"
 )
@@ -19,16 +23,19 @@ func main() {
     location := pvector.PVectorFactory(100, 100)
     velocity := pvector.PVectorFactory(0, 0)
     acceleration := pvector.PVectorFactory(0, 0)
-    mover := mover.MoverFactory(location, velocity, acceleration)
+    mover, err := mover.MoverFactory(location, velocity, acceleration)
+    if err != nil {
+        log.Fatal(err)
+    }
+    mover.SetObserver(event.Printer(os.Stdout))
 
     // Target we are seeking.
     targets := []pvector.PVector{pvector.PVectorFactory(209, 215), pvector.PVectorFactory(400, 400)}
 
-    // External forces.
-    //wind := pvector.PVectorFactory(1000, 0)
-    //gravity := pvector.PVectorFactory(0, -0.01 * mover.mass)
-    //var friction pvector.PVector
-    //var c float64 = 0.01
+    // External forces, applied every time the mover moves.
+    mover.Attach(forces.Wind{Push: pvector.PVectorFactory(1000, 0)})
+    mover.Attach(forces.Gravity{Acceleration: pvector.PVectorFactory(0, -0.01)})
+    mover.Attach(forces.Friction{Coefficient: 0.01})
 
     fmt.Printf("STARTING LOC: %v", location)
     fmt.Println()
@@ -36,24 +43,15 @@ func main() {
 
     // Iterate over time.
     for t := 0; t < 1000; t++ {
-        /*
-        // Compute the friction.
-        friction = mover.velocity
-        friction = friction.mult(-1)
-        friction = friction.normalize()
-        friction = friction.mult(c)
-        */
-
-        // Apply external forces.
-        //mover.applyForce(friction)
-        //mover.applyForce(wind)
-        //mover.applyForce(gravity)
-
         // Seek the target.
-        mover.Seek(targets)
+        if err := mover.Seek(targets); err != nil {
+            log.Fatal(err)
+        }
 
         // Update and display the result.
-        mover.Update()
+        if err := mover.Update(); err != nil {
+            log.Fatal(err)
+        }
     }
 }
 
@@ -12,7 +12,13 @@ package main
 
 import (
     "fmt"
-    "github.com/josephdpurcell/go-neural-network/perceptronNAND"
+    "log"
+    "os"
+    "github.com/josephdpurcell/go-neural-network/activation"
+    "github.com/josephdpurcell/go-neural-network/dataset"
+    "github.com/josephdpurcell/go-neural-network/event"
+    "github.com/josephdpurcell/go-neural-network/perceptron"
+    "github.com/josephdpurcell/go-neural-network/trainer"
 )
 
 /**
@@ -22,52 +28,42 @@ func nand(x1, x2 float64) bool {
     return (x1 == 1 || x2 == 1) && (x1 != 1 || x2 != 1)
 }
 
-/**
- * A trainer has a point (the input) and the answer (nand).
- */
-type Trainer struct {
-    input []float64
-    answer float64
-}
-
 func main() {
-    // Setup the trainers.
-    const count int = 180
-    var trainers [count]Trainer
-    var key int
-    for i := 0; i < (count / 4); i++ {
-        key = (i * 4) + 0
-        trainers[key] = Trainer{
-            input: []float64{1, 0, 0},
-            answer: 1,
-        }
-
-        key = (i * 4) + 1
-        trainers[key] = Trainer{
-            input: []float64{1, 0, 1},
-            answer: 1,
-        }
+    // Setup the truth table, the first input is the bias.
+    samples := dataset.InMemoryFactory()
+    samples.Add([]float64{1, 0, 0}, []float64{1})
+    samples.Add([]float64{1, 0, 1}, []float64{1})
+    samples.Add([]float64{1, 1, 0}, []float64{1})
+    samples.Add([]float64{1, 1, 1}, []float64{0})
 
-        key = (i * 4) + 2
-        trainers[key] = Trainer{
-            input: []float64{1, 1, 0},
-            answer: 1,
-        }
-
-        key = (i * 4) + 3
-        trainers[key] = Trainer{
-            input: []float64{1, 1, 1},
-            answer: 0,
-        }
+    // Learning Constant is low just b/c it's fun to watch, this is not necessarily optimal
+    p, err := perceptron.PerceptronFactory(3, 0.1, activation.Step{Threshold: 0.5})
+    if err != nil {
+        log.Fatal(err)
     }
+    p.SetObserver(event.Printer(os.Stdout))
 
-    // Learning Constant is low just b/c it's fun to watch, this is not necessarily optimal
-    p := perceptronNAND.PerceptronFactory(3, 0.1)
+    // Train our Perceptron until it gets the whole truth table right.
+    t := trainer.TrainerFactory(trainer.PerceptronModel(&p), trainer.Config{
+        Epochs: 45,
+        StopOnZeroError: true,
+        Observer: event.Printer(os.Stdout),
+    })
+    history, err := t.Run(samples)
+    if err != nil {
+        log.Fatal(err)
+    }
+    fmt.Printf("Stopped after %v epochs: %v", len(history.Epochs), history.Reason)
+    fmt.Println()
 
-    // Train our Perceptron.
-    for i := 0; i < len(trainers); i++ {
-        fmt.Printf("%v: ", i)
-        p.Train(trainers[i].input, trainers[i].answer)
+    // Use our Perceptron.
+    for i := 0; i < samples.Len(); i++ {
+        input := samples.At(i).Input
+        output, err := p.Feedforward(input)
+        if err != nil {
+            log.Fatal(err)
+        }
+        fmt.Printf("NAND(%v, %v) = %v", input[1], input[2], output)
+        fmt.Println()
     }
 }
-
@@ -0,0 +1,143 @@
+package event
+
+import (
+    "fmt"
+    "io"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+)
+
+/**
+ * Something that happened while a model was learning or moving.
+ *
+ * Observers switch on the concrete type to get at the details.
+ */
+type Event interface {
+    Kind () string
+}
+
+/**
+ * Receives events as they happen. Models hold a nil Observer by default,
+ * which means nobody is listening and nothing is reported.
+ */
+type Observer func (e Event)
+
+/**
+ * Tell the observer about an event, if there is one.
+ */
+func Notify (o Observer, e Event) {
+    if (o != nil) {
+        o(e)
+    }
+}
+
+/**
+ * A model was trained on one input.
+ *
+ * Output is what the model guessed before it changed its weights, Loss how
+ * far that guess was from Desired, and Weights what the weights are now, when
+ * the model has a single set of them.
+ */
+type Step struct {
+    Input []float64
+    Desired []float64
+    Output []float64
+    Loss float64
+    Weights []float64
+}
+
+func (e Step) Kind () string {
+    return "step"
+}
+
+/**
+ * Whether the guess matched the desired values exactly.
+ */
+func (e Step) Correct () bool {
+    if (len(e.Output) != len(e.Desired)) {
+        return false
+    }
+    for i := 0; i < len(e.Output); i++ {
+        if (e.Output[i] != e.Desired[i]) {
+            return false
+        }
+    }
+    return true
+}
+
+/**
+ * A model went through a whole dataset once.
+ */
+type Epoch struct {
+    Epoch int
+    Loss float64
+    Mistakes int
+}
+
+func (e Epoch) Kind () string {
+    return "epoch"
+}
+
+/**
+ * A mover worked out the force that steers it toward a target.
+ *
+ * Desired is the force it would like to apply and Force is that force after
+ * it was limited to what the mover can manage. Mass is the mover's, so
+ * Force.Div(Mass) is how much the force accelerates it, or 0 when unknown.
+ */
+type Steer struct {
+    Target pvector.PVector
+    Desired pvector.PVector
+    Force pvector.PVector
+    Mass float64
+}
+
+func (e Steer) Kind () string {
+    return "steer"
+}
+
+/**
+ * A mover learned from where it is compared to where it should be.
+ */
+type Seek struct {
+    Location pvector.PVector
+    Desired pvector.PVector
+    Error pvector.PVector
+}
+
+func (e Seek) Kind () string {
+    return "seek"
+}
+
+/**
+ * Create an observer that writes each event as a line of text, like the
+ * demos used to print.
+ */
+func Printer (w io.Writer) Observer {
+    return func (e Event) {
+        switch e := e.(type) {
+        case Step:
+            var verdict string = "Incorrect."
+            if (e.Correct()) {
+                verdict = "Correct!"
+            }
+            if (e.Weights != nil) {
+                fmt.Fprintf(w, "%v Loss: %v. Weights are now: %v\n", verdict, e.Loss, e.Weights)
+            } else {
+                fmt.Fprintf(w, "%v Loss: %v. Output was: %v\n", verdict, e.Loss, e.Output)
+            }
+        case Epoch:
+            fmt.Fprintf(w, "EPOCH %v: loss %v, %v mistakes\n", e.Epoch, e.Loss, e.Mistakes)
+        case Steer:
+            fmt.Fprintf(w, "STEER: %v\n", e.Desired)
+            // Without a mass, print the force as it is.
+            acceleration, _ := e.Force.CheckedDiv(e.Mass)
+            fmt.Fprintf(w, "STEER: %v\n", acceleration)
+        case Seek:
+            fmt.Fprintf(w, "LOC: %v\n", e.Location)
+            fmt.Fprintf(w, "DES: %v\n", e.Desired)
+            fmt.Fprintf(w, "ERROR: %v\n", e.Error)
+        default:
+            fmt.Fprintf(w, "%v: %v\n", e.Kind(), e)
+        }
+    }
+}
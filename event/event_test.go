@@ -0,0 +1,54 @@
+package event
+
+import (
+    "bytes"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+)
+
+func TestNotify(t *testing.T) {
+    // Nobody listening should be fine.
+    Notify(nil, Epoch{Epoch: 1})
+
+    var got []Event
+    Notify(func (e Event) { got = append(got, e) }, Epoch{Epoch: 1})
+    if len(got) != 1 || got[0].Kind() != "epoch" {
+        t.Errorf("Notify should have passed the event on, but got %v", got)
+    }
+}
+
+func TestStepCorrect(t *testing.T) {
+    e := Step{Desired: []float64{1}, Output: []float64{1}}
+    if !e.Correct() {
+        t.Errorf("%v should be correct", e)
+    }
+
+    e = Step{Desired: []float64{1}, Output: []float64{0}}
+    if e.Correct() {
+        t.Errorf("%v should be incorrect", e)
+    }
+}
+
+func TestPrinter(t *testing.T) {
+    var b bytes.Buffer
+    p := Printer(&b)
+
+    p(Step{Desired: []float64{1}, Output: []float64{1}, Loss: 0, Weights: []float64{0.5, 1}})
+    p(Step{Desired: []float64{1}, Output: []float64{0}, Loss: 1, Weights: []float64{0.5, 1}})
+    p(Steer{Desired: pvector.PVectorFactory(6, 8), Force: pvector.PVectorFactory(3, 4), Mass: 2})
+    p(Steer{Desired: pvector.PVectorFactory(6, 8), Force: pvector.PVectorFactory(3, 4)})
+    p(Seek{Location: pvector.PVectorFactory(1, 2), Desired: pvector.PVectorFactory(3, 4), Error: pvector.PVectorFactory(2, 2)})
+
+    want := "Correct! Loss: 0. Weights are now: [0.5 1]\n" +
+        "Incorrect. Loss: 1. Weights are now: [0.5 1]\n" +
+        "STEER: {6 8}\n" +
+        "STEER: {1.5 2}\n" +
+        "STEER: {6 8}\n" +
+        "STEER: {3 4}\n" +
+        "LOC: {1 2}\n" +
+        "DES: {3 4}\n" +
+        "ERROR: {2 2}\n"
+    if b.String() != want {
+        t.Errorf("Printer wrote %q, want %q", b.String(), want)
+    }
+}
@@ -0,0 +1,126 @@
+package forces
+
+import (
+    "math"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+/**
+ * Anything forces can push on, like a Mover.
+ */
+type Body interface {
+    Location () pvector.PVector
+    Velocity () pvector.PVector
+    Mass () float64
+}
+
+/**
+ * Something in the environment that pushes on a body, worked out fresh every
+ * time since bodies move.
+ */
+type Force interface {
+    Force (b Body) pvector.PVector
+}
+
+/**
+ * Pulls every body the same way with the same acceleration, so heavier bodies
+ * get a larger force.
+ */
+type Gravity struct {
+    Acceleration pvector.PVector
+}
+
+func (g Gravity) Force (b Body) pvector.PVector {
+    return g.Acceleration.Mult(b.Mass())
+}
+
+/**
+ * Slows a body moving through a fluid, like air or water, by more the faster
+ * it goes: the force is Coefficient * speed^2, against the velocity.
+ */
+type Drag struct {
+    Coefficient float64
+}
+
+func (d Drag) Force (b Body) pvector.PVector {
+    speed := b.Velocity().Mag()
+    return b.Velocity().Normalize().Mult(-d.Coefficient * speed * speed)
+}
+
+/**
+ * Slows a body sliding over a surface by the same amount whatever its speed,
+ * against the velocity. The surface pushes back with a normal force of 1, as
+ * in The Nature of Code, so Coefficient is the whole force.
+ */
+type Friction struct {
+    Coefficient float64
+}
+
+func (f Friction) Force (b Body) pvector.PVector {
+    return b.Velocity().Normalize().Mult(-f.Coefficient)
+}
+
+/**
+ * Pushes every body with the same force wherever it is.
+ */
+type Wind struct {
+    Push pvector.PVector
+}
+
+func (w Wind) Force (b Body) pvector.PVector {
+    return w.Push
+}
+
+/**
+ * A force that depends on where the body is, like wind that blows
+ * differently in different places.
+ */
+type Field func (location pvector.PVector) pvector.PVector
+
+func (f Field) Force (b Body) pvector.PVector {
+    return f(b.Location())
+}
+
+/**
+ * Wind of the given strength whose direction drifts smoothly from place to
+ * place, following Perlin noise. The smaller scale is, the further apart
+ * places must be for the wind to blow differently.
+ */
+func NoiseFieldFactory (strength, scale float64, src *random.Source) Field {
+    noise := random.PerlinFactory(src)
+    return func (location pvector.PVector) pvector.PVector {
+        theta := noise.Noise2D(location.X * scale, location.Y * scale) * 2 * math.Pi
+        return pvector.PVectorFactory(strength, 0).Rotate(theta)
+    }
+}
+
+/**
+ * Ties a body to an anchor with a spring that pulls it back when stretched
+ * past Rest and pushes it away when squashed: the force is
+ * Stiffness * (length - Rest), along the spring.
+ *
+ * A body sitting on the anchor has no direction to be pushed in, so feels
+ * nothing.
+ */
+type Spring struct {
+    Anchor pvector.PVector
+    Rest float64
+    Stiffness float64
+}
+
+func (s Spring) Force (b Body) pvector.PVector {
+    stretch := b.Location().Sub(s.Anchor)
+    return stretch.Normalize().Mult(-s.Stiffness * (stretch.Mag() - s.Rest))
+}
+
+/**
+ * The total of several forces on a body.
+ */
+func Sum (b Body, forces ...Force) pvector.PVector {
+    var total pvector.PVector
+    for _, f := range forces {
+        total = total.Add(f.Force(b))
+    }
+    return total
+}
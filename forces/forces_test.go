@@ -0,0 +1,86 @@
+package forces
+
+import (
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+/**
+ * A Body that stays where it is put.
+ */
+type body struct {
+    location pvector.PVector
+    velocity pvector.PVector
+    mass float64
+}
+
+func (b body) Location () pvector.PVector {
+    return b.location
+}
+
+func (b body) Velocity () pvector.PVector {
+    return b.velocity
+}
+
+func (b body) Mass () float64 {
+    return b.mass
+}
+
+func close(a, b pvector.PVector) bool {
+    return math.Abs(a.X - b.X) < 1e-9 && math.Abs(a.Y - b.Y) < 1e-9
+}
+
+func TestForces(t *testing.T) {
+    moving := body{location: pvector.PVectorFactory(3, 4), velocity: pvector.PVectorFactory(0, 2), mass: 10}
+    still := body{location: pvector.PVectorFactory(0, 0), velocity: pvector.PVectorFactory(0, 0), mass: 10}
+
+    tests := []struct {
+        name string
+        force Force
+        b body
+        want pvector.PVector
+    }{
+        {"Gravity", Gravity{Acceleration: pvector.PVectorFactory(0, -0.5)}, moving, pvector.PVectorFactory(0, -5)},
+        {"Drag", Drag{Coefficient: 0.1}, moving, pvector.PVectorFactory(0, -0.4)},
+        {"Drag when still", Drag{Coefficient: 0.1}, still, pvector.PVectorFactory(0, 0)},
+        {"Friction", Friction{Coefficient: 0.3}, moving, pvector.PVectorFactory(0, -0.3)},
+        {"Friction when still", Friction{Coefficient: 0.3}, still, pvector.PVectorFactory(0, 0)},
+        {"Wind", Wind{Push: pvector.PVectorFactory(1, 0)}, moving, pvector.PVectorFactory(1, 0)},
+        {"Field", Field(func (l pvector.PVector) pvector.PVector { return l.Mult(2) }), moving, pvector.PVectorFactory(6, 8)},
+        {"Spring stretched", Spring{Anchor: pvector.PVectorFactory(0, 0), Rest: 2, Stiffness: 1}, moving, pvector.PVectorFactory(-1.8, -2.4)},
+        {"Spring squashed", Spring{Anchor: pvector.PVectorFactory(0, 0), Rest: 10, Stiffness: 1}, moving, pvector.PVectorFactory(3, 4)},
+        {"Spring at rest", Spring{Anchor: pvector.PVectorFactory(0, 0), Rest: 5, Stiffness: 1}, moving, pvector.PVectorFactory(0, 0)},
+        {"Spring on its anchor", Spring{Anchor: pvector.PVectorFactory(0, 0), Rest: 5, Stiffness: 1}, still, pvector.PVectorFactory(0, 0)},
+    }
+
+    for _, test := range tests {
+        if got := test.force.Force(test.b); !close(got, test.want) {
+            t.Errorf("%v == %v, want %v", test.name, got, test.want)
+        }
+    }
+
+    got := Sum(moving, Wind{Push: pvector.PVectorFactory(1, 0)}, Gravity{Acceleration: pvector.PVectorFactory(0, -0.5)})
+    if !close(got, pvector.PVectorFactory(1, -5)) {
+        t.Errorf("Sum() == %v, want %v", got, pvector.PVectorFactory(1, -5))
+    }
+}
+
+func TestNoiseField(t *testing.T) {
+    field := NoiseFieldFactory(2, 0.01, random.SourceFactory(1))
+    a := field(pvector.PVectorFactory(10, 10))
+    b := field(pvector.PVectorFactory(10.5, 10))
+
+    if math.Abs(a.Mag() - 2) > 1e-9 {
+        t.Errorf("Wind should have a strength of 2, but is %v", a)
+    }
+    if a.AngleBetween(b) > 0.1 {
+        t.Errorf("Wind should change smoothly, but turned from %v to %v", a, b)
+    }
+
+    same := NoiseFieldFactory(2, 0.01, random.SourceFactory(1))
+    if !close(same(pvector.PVectorFactory(10, 10)), a) {
+        t.Errorf("The same seed should give the same wind")
+    }
+}
@@ -0,0 +1,156 @@
+package initializer
+
+import (
+    "math"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+/**
+ * An initializer picks the starting weights of a model.
+ *
+ * fanIn is the number of inputs feeding each neuron and fanOut the number of
+ * neurons, which the scaled initializers like Xavier and He use to keep
+ * signals from growing or shrinking as they pass through layers.
+ */
+type Initializer interface {
+    Initialize (weights []float64, fanIn, fanOut int)
+}
+
+/**
+ * Every weight starts at the same value.
+ */
+type Constant struct {
+    value float64
+}
+
+func (i Constant) Initialize (weights []float64, fanIn, fanOut int) {
+    for j := 0; j < len(weights); j++ {
+        weights[j] = i.value
+    }
+}
+
+/**
+ * Create an initializer that sets every weight to value.
+ */
+func ConstantFactory (value float64) Constant {
+    return Constant{value: value}
+}
+
+/**
+ * Create an initializer that sets every weight to 0.
+ */
+func ZerosFactory () Constant {
+    return ConstantFactory(0)
+}
+
+/**
+ * Weights are drawn uniformly between min and max.
+ */
+type Uniform struct {
+    min float64
+    max float64
+    src *random.Source
+}
+
+func (i Uniform) Initialize (weights []float64, fanIn, fanOut int) {
+    for j := 0; j < len(weights); j++ {
+        weights[j] = i.src.Random(i.min, i.max)
+    }
+}
+
+/**
+ * Create an initializer drawing uniformly between min and max.
+ */
+func UniformFactory (min, max float64, src *random.Source) Uniform {
+    return Uniform{
+        min: min,
+        max: max,
+        src: src,
+    }
+}
+
+/**
+ * Weights are drawn from a normal distribution.
+ */
+type Normal struct {
+    mean float64
+    stddev float64
+    src *random.Source
+}
+
+func (i Normal) Initialize (weights []float64, fanIn, fanOut int) {
+    for j := 0; j < len(weights); j++ {
+        weights[j] = i.src.Gaussian(i.mean, i.stddev)
+    }
+}
+
+/**
+ * Create an initializer drawing from a normal distribution.
+ */
+func NormalFactory (mean, stddev float64, src *random.Source) Normal {
+    return Normal{
+        mean: mean,
+        stddev: stddev,
+        src: src,
+    }
+}
+
+/**
+ * Xavier (a.k.a. Glorot) initialization, suited to sigmoid and tanh layers:
+ * uniform within ±sqrt(6 / (fanIn + fanOut)).
+ */
+type Xavier struct {
+    src *random.Source
+}
+
+func (i Xavier) Initialize (weights []float64, fanIn, fanOut int) {
+    limit := math.Sqrt(6 / float64(fanIn + fanOut))
+    UniformFactory(-limit, limit, i.src).Initialize(weights, fanIn, fanOut)
+}
+
+/**
+ * Create a Xavier initializer.
+ */
+func XavierFactory (src *random.Source) Xavier {
+    return Xavier{src: src}
+}
+
+/**
+ * Xavier initialization drawn from a normal distribution with a standard
+ * deviation of sqrt(2 / (fanIn + fanOut)).
+ */
+type XavierNormal struct {
+    src *random.Source
+}
+
+func (i XavierNormal) Initialize (weights []float64, fanIn, fanOut int) {
+    stddev := math.Sqrt(2 / float64(fanIn + fanOut))
+    NormalFactory(0, stddev, i.src).Initialize(weights, fanIn, fanOut)
+}
+
+/**
+ * Create a normal Xavier initializer.
+ */
+func XavierNormalFactory (src *random.Source) XavierNormal {
+    return XavierNormal{src: src}
+}
+
+/**
+ * He initialization, suited to ReLU layers: normal with a standard deviation
+ * of sqrt(2 / fanIn).
+ */
+type He struct {
+    src *random.Source
+}
+
+func (i He) Initialize (weights []float64, fanIn, fanOut int) {
+    stddev := math.Sqrt(2 / float64(fanIn))
+    NormalFactory(0, stddev, i.src).Initialize(weights, fanIn, fanOut)
+}
+
+/**
+ * Create a He initializer.
+ */
+func HeFactory (src *random.Source) He {
+    return He{src: src}
+}
@@ -0,0 +1,90 @@
+package initializer
+
+import (
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+/**
+ * The mean and standard deviation of some weights.
+ */
+func stats(weights []float64) (float64, float64) {
+    var sum, squares float64 = 0, 0
+    for _, w := range weights {
+        sum = sum + w
+        squares = squares + (w * w)
+    }
+    n := float64(len(weights))
+    mean := sum / n
+    return mean, math.Sqrt((squares / n) - (mean * mean))
+}
+
+func TestConstant(t *testing.T) {
+    weights := []float64{1, 2, 3}
+
+    ZerosFactory().Initialize(weights, 3, 1)
+    for _, w := range weights {
+        if w != 0 {
+            t.Errorf("ZerosFactory() gave %v, want all 0", weights)
+            break
+        }
+    }
+
+    ConstantFactory(1).Initialize(weights, 3, 1)
+    for _, w := range weights {
+        if w != 1 {
+            t.Errorf("ConstantFactory(1) gave %v, want all 1", weights)
+            break
+        }
+    }
+}
+
+func TestUniform(t *testing.T) {
+    weights := make([]float64, 1000)
+    UniformFactory(-1, 1, random.SourceFactory(1)).Initialize(weights, 10, 10)
+
+    for _, w := range weights {
+        if w < -1 || w > 1 {
+            t.Fatalf("UniformFactory(-1, 1) gave %v, want between -1 and 1", w)
+        }
+    }
+}
+
+func TestScaled(t *testing.T) {
+    tests := []struct {
+        i Initializer
+        fanIn int
+        fanOut int
+        stddev float64
+    }{
+        {NormalFactory(0, 0.5, random.SourceFactory(1)), 10, 10, 0.5},
+        // Uniform within ±a has a standard deviation of a / sqrt(3).
+        {XavierFactory(random.SourceFactory(1)), 100, 50, math.Sqrt(6.0 / 150) / math.Sqrt(3)},
+        {XavierNormalFactory(random.SourceFactory(1)), 100, 50, math.Sqrt(2.0 / 150)},
+        {HeFactory(random.SourceFactory(1)), 100, 50, math.Sqrt(2.0 / 100)},
+    }
+
+    for _, test := range tests {
+        weights := make([]float64, 20000)
+        test.i.Initialize(weights, test.fanIn, test.fanOut)
+        mean, stddev := stats(weights)
+        if math.Abs(mean) > 0.01 || math.Abs(stddev - test.stddev) > test.stddev * 0.05 {
+            t.Errorf("%T gave mean %v and stddev %v, want 0 and %v", test.i, mean, stddev, test.stddev)
+        }
+    }
+}
+
+func TestSeeded(t *testing.T) {
+    a := make([]float64, 5)
+    b := make([]float64, 5)
+    HeFactory(random.SourceFactory(3)).Initialize(a, 5, 1)
+    HeFactory(random.SourceFactory(3)).Initialize(b, 5, 1)
+
+    for i := 0; i < len(a); i++ {
+        if a[i] != b[i] {
+            t.Errorf("The same seed should give the same weights, but gave %v and %v", a, b)
+            break
+        }
+    }
+}
@@ -0,0 +1,186 @@
+package loss
+
+import (
+    "math"
+)
+
+/**
+ * A loss measures how far a model's outputs are from the desired values.
+ *
+ * Value is the loss itself and Gradient is its derivative with respect to each
+ * output, which is what a model needs to learn from it.
+ */
+type Loss interface {
+    Value (output, desired []float64) float64
+    Gradient (output, desired []float64) []float64
+}
+
+/**
+ * Outputs are clipped this far away from 0 and 1 before taking a logarithm so
+ * that a confident, wrong answer gives a large loss rather than infinity.
+ */
+const epsilon float64 = 1e-12
+
+/**
+ * Mean squared error: the average of (output - desired)^2.
+ */
+type MSE struct{}
+
+func (l MSE) Value (output, desired []float64) float64 {
+    var sum float64 = 0
+    for i := 0; i < len(output); i++ {
+        diff := output[i] - desired[i]
+        sum = sum + (diff * diff)
+    }
+    return sum / float64(len(output))
+}
+
+func (l MSE) Gradient (output, desired []float64) []float64 {
+    g := make([]float64, len(output))
+    n := float64(len(output))
+    for i := 0; i < len(output); i++ {
+        g[i] = 2 * (output[i] - desired[i]) / n
+    }
+    return g
+}
+
+/**
+ * Mean absolute error: the average of |output - desired|.
+ */
+type MAE struct{}
+
+func (l MAE) Value (output, desired []float64) float64 {
+    var sum float64 = 0
+    for i := 0; i < len(output); i++ {
+        sum = sum + math.Abs(output[i] - desired[i])
+    }
+    return sum / float64(len(output))
+}
+
+func (l MAE) Gradient (output, desired []float64) []float64 {
+    g := make([]float64, len(output))
+    n := float64(len(output))
+    for i := 0; i < len(output); i++ {
+        diff := output[i] - desired[i]
+        if (diff > 0) {
+            g[i] = 1 / n
+        } else if (diff < 0) {
+            g[i] = -1 / n
+        }
+    }
+    return g
+}
+
+/**
+ * Huber loss: squared error for differences up to Delta, absolute error
+ * beyond it, so that outliers do not dominate.
+ */
+type Huber struct {
+    Delta float64
+}
+
+func (l Huber) Value (output, desired []float64) float64 {
+    var sum float64 = 0
+    for i := 0; i < len(output); i++ {
+        diff := math.Abs(output[i] - desired[i])
+        if (diff <= l.Delta) {
+            sum = sum + (diff * diff / 2)
+        } else {
+            sum = sum + (l.Delta * (diff - (l.Delta / 2)))
+        }
+    }
+    return sum / float64(len(output))
+}
+
+func (l Huber) Gradient (output, desired []float64) []float64 {
+    g := make([]float64, len(output))
+    n := float64(len(output))
+    for i := 0; i < len(output); i++ {
+        diff := output[i] - desired[i]
+        if (math.Abs(diff) <= l.Delta) {
+            g[i] = diff / n
+        } else {
+            g[i] = math.Copysign(l.Delta, diff) / n
+        }
+    }
+    return g
+}
+
+/**
+ * Binary cross-entropy for outputs that are probabilities of desired values
+ * of 0 or 1, e.g. from a sigmoid.
+ */
+type BinaryCrossEntropy struct{}
+
+func (l BinaryCrossEntropy) Value (output, desired []float64) float64 {
+    var sum float64 = 0
+    for i := 0; i < len(output); i++ {
+        o := clip(output[i])
+        sum = sum - ((desired[i] * math.Log(o)) + ((1 - desired[i]) * math.Log(1 - o)))
+    }
+    return sum / float64(len(output))
+}
+
+func (l BinaryCrossEntropy) Gradient (output, desired []float64) []float64 {
+    g := make([]float64, len(output))
+    n := float64(len(output))
+    for i := 0; i < len(output); i++ {
+        o := clip(output[i])
+        g[i] = ((o - desired[i]) / (o * (1 - o))) / n
+    }
+    return g
+}
+
+/**
+ * Categorical cross-entropy for outputs that are a probability per class,
+ * e.g. from a softmax, against a one-hot desired value.
+ */
+type CategoricalCrossEntropy struct{}
+
+func (l CategoricalCrossEntropy) Value (output, desired []float64) float64 {
+    var sum float64 = 0
+    for i := 0; i < len(output); i++ {
+        sum = sum - (desired[i] * math.Log(clip(output[i])))
+    }
+    return sum
+}
+
+func (l CategoricalCrossEntropy) Gradient (output, desired []float64) []float64 {
+    g := make([]float64, len(output))
+    for i := 0; i < len(output); i++ {
+        g[i] = -desired[i] / clip(output[i])
+    }
+    return g
+}
+
+/**
+ * Hinge loss for raw scores against desired values of -1 or 1, as used by the
+ * sign-activated Perceptron: max(0, 1 - desired * output).
+ */
+type Hinge struct{}
+
+func (l Hinge) Value (output, desired []float64) float64 {
+    var sum float64 = 0
+    for i := 0; i < len(output); i++ {
+        sum = sum + math.Max(0, 1 - (desired[i] * output[i]))
+    }
+    return sum / float64(len(output))
+}
+
+func (l Hinge) Gradient (output, desired []float64) []float64 {
+    g := make([]float64, len(output))
+    n := float64(len(output))
+    for i := 0; i < len(output); i++ {
+        if ((desired[i] * output[i]) < 1) {
+            g[i] = -desired[i] / n
+        }
+    }
+    return g
+}
+
+/**
+ * Keep a probability away from exactly 0 and 1.
+ */
+func clip (p float64) float64 {
+    return math.Min(math.Max(p, epsilon), 1 - epsilon)
+}
@@ -0,0 +1,73 @@
+package loss
+
+import (
+    "math"
+    "testing"
+)
+
+func TestValue(t *testing.T) {
+    tests := []struct {
+        l Loss
+        output []float64
+        desired []float64
+        want float64
+    }{
+        {MSE{}, []float64{1, 2}, []float64{1, 4}, 2},
+        {MAE{}, []float64{1, 2}, []float64{2, 4}, 1.5},
+        {Huber{Delta: 1}, []float64{0.5}, []float64{0}, 0.125},
+        {Huber{Delta: 1}, []float64{3}, []float64{0}, 2.5},
+        {BinaryCrossEntropy{}, []float64{0.5}, []float64{1}, math.Log(2)},
+        {CategoricalCrossEntropy{}, []float64{0.25, 0.75}, []float64{0, 1}, -math.Log(0.75)},
+        {Hinge{}, []float64{2}, []float64{1}, 0},
+        {Hinge{}, []float64{0.5}, []float64{-1}, 1.5},
+    }
+
+    for _, test := range tests {
+        got := test.l.Value(test.output, test.desired)
+        if math.Abs(got - test.want) > 1e-12 {
+            t.Errorf("%T.Value(%v, %v) == %v, want %v", test.l, test.output, test.desired, got, test.want)
+        }
+    }
+}
+
+func TestGradient(t *testing.T) {
+    tests := []struct {
+        l Loss
+        output []float64
+        desired []float64
+    }{
+        {MSE{}, []float64{0.2, -1.3}, []float64{1, 0}},
+        {MAE{}, []float64{0.2, -1.3}, []float64{1, 0}},
+        {Huber{Delta: 1}, []float64{0.2, -1.3}, []float64{1, 0}},
+        {BinaryCrossEntropy{}, []float64{0.2, 0.9}, []float64{1, 0}},
+        {CategoricalCrossEntropy{}, []float64{0.2, 0.8}, []float64{1, 0}},
+        {Hinge{}, []float64{0.2, -1.3}, []float64{1, -1}},
+    }
+    const h float64 = 1e-6
+
+    for _, test := range tests {
+        got := test.l.Gradient(test.output, test.desired)
+        for i := 0; i < len(test.output); i++ {
+            up := append([]float64{}, test.output...)
+            down := append([]float64{}, test.output...)
+            up[i] = up[i] + h
+            down[i] = down[i] - h
+            want := (test.l.Value(up, test.desired) - test.l.Value(down, test.desired)) / (2 * h)
+            if math.Abs(got[i] - want) > 1e-4 {
+                t.Errorf("%T.Gradient(%v, %v)[%v] == %v, want %v", test.l, test.output, test.desired, i, got[i], want)
+            }
+        }
+    }
+}
+
+func TestCrossEntropyClipping(t *testing.T) {
+    got := BinaryCrossEntropy{}.Value([]float64{0}, []float64{1})
+    if math.IsInf(got, 0) || math.IsNaN(got) {
+        t.Errorf("BinaryCrossEntropy{}.Value({0}, {1}) == %v, want a finite loss", got)
+    }
+
+    got = CategoricalCrossEntropy{}.Value([]float64{1, 0}, []float64{0, 1})
+    if math.IsInf(got, 0) || math.IsNaN(got) {
+        t.Errorf("CategoricalCrossEntropy{}.Value({1, 0}, {0, 1}) == %v, want a finite loss", got)
+    }
+}
@@ -0,0 +1,405 @@
+package mat
+
+import (
+    "fmt"
+    "math"
+)
+
+/**
+ * The dimensions of two operands do not fit together: Rows x Cols is what was
+ * expected, or the first operand, and OtherRows x OtherCols is what was given.
+ *
+ * Vectors are treated as a single row.
+ */
+type DimensionError struct {
+    Op string
+    Rows int
+    Cols int
+    OtherRows int
+    OtherCols int
+}
+
+func (e *DimensionError) Error () string {
+    if (e.Rows == 1 && e.OtherRows == 1) {
+        return fmt.Sprintf("%v: want %v values, got %v", e.Op, e.Cols, e.OtherCols)
+    }
+    return fmt.Sprintf("%v: %vx%v does not fit %vx%v", e.Op, e.Rows, e.Cols, e.OtherRows, e.OtherCols)
+}
+
+/**
+ * Check that a vector has the length wanted, returning a *DimensionError
+ * naming op when it does not.
+ */
+func CheckLength (op string, want, got int) error {
+    if (want != got) {
+        return &DimensionError{Op: op, Rows: 1, Cols: want, OtherRows: 1, OtherCols: got}
+    }
+    return nil
+}
+
+/**
+ * A dense matrix of float64s, stored row by row.
+ *
+ * Operations return a new Matrix and leave their operands alone, except Set
+ * and the slices returned by Row, which share the Matrix's storage. Mixing
+ * matrices whose dimensions do not fit panics with a *DimensionError, so
+ * anything taking outside input should check it first.
+ */
+type Matrix struct {
+    rows int
+    cols int
+    data []float64
+}
+
+/**
+ * The means of creating a Matrix.
+ *
+ * data holds the values row by row and is used as-is, not copied. When nil,
+ * every value starts at 0.
+ */
+func MatrixFactory (rows, cols int, data []float64) Matrix {
+    if (data == nil) {
+        data = make([]float64, rows * cols)
+    }
+    if (len(data) != rows * cols) {
+        mismatch("MatrixFactory", 1, rows * cols, 1, len(data))
+    }
+    m := Matrix{
+        rows: rows,
+        cols: cols,
+        data: data,
+    }
+    return m
+}
+
+/**
+ * Create a Matrix by copying rows, which must all be the same length.
+ */
+func RowsFactory (rows [][]float64) Matrix {
+    if (len(rows) == 0) {
+        return MatrixFactory(0, 0, nil)
+    }
+    m := MatrixFactory(len(rows), len(rows[0]), nil)
+    for i := 0; i < len(rows); i++ {
+        if (len(rows[i]) != m.cols) {
+            mismatch("RowsFactory", 1, m.cols, 1, len(rows[i]))
+        }
+        copy(m.Row(i), rows[i])
+    }
+    return m
+}
+
+/**
+ * Create a 1xn Matrix from a copy of a vector.
+ */
+func RowFactory (v []float64) Matrix {
+    return MatrixFactory(1, len(v), append([]float64{}, v...))
+}
+
+/**
+ * Create an nx1 Matrix from a copy of a vector.
+ */
+func ColumnFactory (v []float64) Matrix {
+    return MatrixFactory(len(v), 1, append([]float64{}, v...))
+}
+
+/**
+ * The number of rows and columns.
+ */
+func (m Matrix) Dims () (int, int) {
+    return m.rows, m.cols
+}
+
+/**
+ * The value at row i, column j.
+ */
+func (m Matrix) At (i, j int) float64 {
+    return m.data[(i * m.cols) + j]
+}
+
+/**
+ * Change the value at row i, column j.
+ */
+func (m Matrix) Set (i, j int, v float64) {
+    m.data[(i * m.cols) + j] = v
+}
+
+/**
+ * Row i. Changing the returned slice changes the Matrix.
+ */
+func (m Matrix) Row (i int) []float64 {
+    return m.data[i * m.cols : (i + 1) * m.cols : (i + 1) * m.cols]
+}
+
+/**
+ * A copy of column j.
+ */
+func (m Matrix) Col (j int) []float64 {
+    c := make([]float64, m.rows)
+    for i := 0; i < m.rows; i++ {
+        c[i] = m.At(i, j)
+    }
+    return c
+}
+
+/**
+ * A copy of every row.
+ */
+func (m Matrix) Rows () [][]float64 {
+    rows := make([][]float64, m.rows)
+    for i := 0; i < m.rows; i++ {
+        rows[i] = append([]float64{}, m.Row(i)...)
+    }
+    return rows
+}
+
+/**
+ * A copy that shares nothing with the original.
+ */
+func (m Matrix) Copy () Matrix {
+    return MatrixFactory(m.rows, m.cols, append([]float64{}, m.data...))
+}
+
+/**
+ * Multiply by a vector: the result has one value per row, the dot product of
+ * that row with v.
+ */
+func (m Matrix) MulVec (v []float64) []float64 {
+    if (len(v) != m.cols) {
+        mismatch("MulVec", 1, m.cols, 1, len(v))
+    }
+    result := make([]float64, m.rows)
+    for i := 0; i < m.rows; i++ {
+        result[i] = Dot(m.Row(i), v)
+    }
+    return result
+}
+
+/**
+ * Matrix multiplication.
+ */
+func (m Matrix) Mul (b Matrix) Matrix {
+    if (m.cols != b.rows) {
+        mismatch("Mul", m.rows, m.cols, b.rows, b.cols)
+    }
+    result := MatrixFactory(m.rows, b.cols, nil)
+    for i := 0; i < m.rows; i++ {
+        row := result.Row(i)
+        for k := 0; k < m.cols; k++ {
+            a := m.At(i, k)
+            for j := 0; j < b.cols; j++ {
+                row[j] = row[j] + (a * b.At(k, j))
+            }
+        }
+    }
+    return result
+}
+
+/**
+ * The transpose, with rows and columns swapped.
+ */
+func (m Matrix) T () Matrix {
+    result := MatrixFactory(m.cols, m.rows, nil)
+    for i := 0; i < m.rows; i++ {
+        for j := 0; j < m.cols; j++ {
+            result.Set(j, i, m.At(i, j))
+        }
+    }
+    return result
+}
+
+/**
+ * Add element by element, broadcasting b if needed.
+ */
+func (m Matrix) Add (b Matrix) Matrix {
+    return m.elementwise("Add", b, func (x, y float64) float64 {
+        return x + y
+    })
+}
+
+/**
+ * Subtract element by element, broadcasting b if needed.
+ */
+func (m Matrix) Sub (b Matrix) Matrix {
+    return m.elementwise("Sub", b, func (x, y float64) float64 {
+        return x - y
+    })
+}
+
+/**
+ * Multiply element by element, broadcasting b if needed.
+ */
+func (m Matrix) MulElem (b Matrix) Matrix {
+    return m.elementwise("MulElem", b, func (x, y float64) float64 {
+        return x * y
+    })
+}
+
+/**
+ * Divide element by element, broadcasting b if needed.
+ */
+func (m Matrix) DivElem (b Matrix) Matrix {
+    return m.elementwise("DivElem", b, func (x, y float64) float64 {
+        return x / y
+    })
+}
+
+/**
+ * Multiply every value by s.
+ */
+func (m Matrix) Scale (s float64) Matrix {
+    return m.Apply(func (x float64) float64 {
+        return x * s
+    })
+}
+
+/**
+ * Apply f to every value.
+ */
+func (m Matrix) Apply (f func (float64) float64) Matrix {
+    result := MatrixFactory(m.rows, m.cols, nil)
+    for i := 0; i < len(m.data); i++ {
+        result.data[i] = f(m.data[i])
+    }
+    return result
+}
+
+/**
+ * Combine two matrices element by element.
+ *
+ * Broadcasting means b may have a single row or a single column (or both), in
+ * which case it is repeated to match m, e.g. adding a 1xn bias row to every
+ * row of m.
+ */
+func (m Matrix) elementwise (op string, b Matrix, f func (x, y float64) float64) Matrix {
+    if ((b.rows != m.rows && b.rows != 1) || (b.cols != m.cols && b.cols != 1)) {
+        mismatch(op, m.rows, m.cols, b.rows, b.cols)
+    }
+    result := MatrixFactory(m.rows, m.cols, nil)
+    for i := 0; i < m.rows; i++ {
+        bi := i
+        if (b.rows == 1) {
+            bi = 0
+        }
+        for j := 0; j < m.cols; j++ {
+            bj := j
+            if (b.cols == 1) {
+                bj = 0
+            }
+            result.Set(i, j, f(m.At(i, j), b.At(bi, bj)))
+        }
+    }
+    return result
+}
+
+/**
+ * The sum of every value.
+ */
+func (m Matrix) Sum () float64 {
+    var sum float64 = 0
+    for i := 0; i < len(m.data); i++ {
+        sum = sum + m.data[i]
+    }
+    return sum
+}
+
+/**
+ * The mean of every value, or 0 for an empty Matrix.
+ */
+func (m Matrix) Mean () float64 {
+    if (len(m.data) == 0) {
+        return 0
+    }
+    return m.Sum() / float64(len(m.data))
+}
+
+/**
+ * The largest value, or -Inf for an empty Matrix.
+ */
+func (m Matrix) Max () float64 {
+    max := math.Inf(-1)
+    for i := 0; i < len(m.data); i++ {
+        max = math.Max(max, m.data[i])
+    }
+    return max
+}
+
+/**
+ * The smallest value, or +Inf for an empty Matrix.
+ */
+func (m Matrix) Min () float64 {
+    min := math.Inf(1)
+    for i := 0; i < len(m.data); i++ {
+        min = math.Min(min, m.data[i])
+    }
+    return min
+}
+
+/**
+ * The sum of each row, one value per row.
+ */
+func (m Matrix) SumRows () []float64 {
+    sums := make([]float64, m.rows)
+    for i := 0; i < m.rows; i++ {
+        for _, v := range m.Row(i) {
+            sums[i] = sums[i] + v
+        }
+    }
+    return sums
+}
+
+/**
+ * The sum of each column, one value per column.
+ */
+func (m Matrix) SumCols () []float64 {
+    sums := make([]float64, m.cols)
+    for i := 0; i < m.rows; i++ {
+        for j, v := range m.Row(i) {
+            sums[j] = sums[j] + v
+        }
+    }
+    return sums
+}
+
+/**
+ * The dot product of two vectors.
+ */
+func Dot (a, b []float64) float64 {
+    if (len(a) != len(b)) {
+        mismatch("Dot", 1, len(a), 1, len(b))
+    }
+    var sum float64 = 0
+    for i := 0; i < len(a); i++ {
+        sum = sum + (a[i] * b[i])
+    }
+    return sum
+}
+
+/**
+ * The outer product of two vectors: row i is b scaled by a[i].
+ */
+func Outer (a, b []float64) Matrix {
+    return ColumnFactory(a).Mul(RowFactory(b))
+}
+
+/**
+ * Add two vectors of the same length. Unlike Add, a vector of length 1 is not
+ * broadcast.
+ */
+func AddVec (a, b []float64) []float64 {
+    if (len(a) != len(b)) {
+        mismatch("AddVec", 1, len(a), 1, len(b))
+    }
+    return RowFactory(a).Add(RowFactory(b)).data
+}
+
+/**
+ * Multiply every value of a vector by s.
+ */
+func ScaleVec (v []float64, s float64) []float64 {
+    return RowFactory(v).Scale(s).data
+}
+
+func mismatch (op string, r1, c1, r2, c2 int) {
+    panic(&DimensionError{Op: "mat: " + op, Rows: r1, Cols: c1, OtherRows: r2, OtherCols: c2})
+}
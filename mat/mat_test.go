@@ -0,0 +1,178 @@
+package mat
+
+import (
+    "math"
+    "reflect"
+    "testing"
+)
+
+func TestMatrixFactory(t *testing.T) {
+    m := MatrixFactory(2, 3, nil)
+    if r, c := m.Dims(); r != 2 || c != 3 {
+        t.Errorf("m.Dims() == %v, %v, want %v, %v", r, c, 2, 3)
+    }
+    if m.Sum() != 0 {
+        t.Errorf("A new Matrix should be all 0, but is %v", m.Rows())
+    }
+
+    m = RowsFactory([][]float64{{1, 2, 3}, {4, 5, 6}})
+    if m.At(1, 0) != 4 {
+        t.Errorf("m.At(1, 0) == %v, want %v", m.At(1, 0), 4)
+    }
+    m.Set(1, 0, 7)
+    if !reflect.DeepEqual(m.Rows(), [][]float64{{1, 2, 3}, {7, 5, 6}}) {
+        t.Errorf("m.Set(1, 0, 7) gave %v", m.Rows())
+    }
+    if !reflect.DeepEqual(m.Col(1), []float64{2, 5}) {
+        t.Errorf("m.Col(1) == %v, want %v", m.Col(1), []float64{2, 5})
+    }
+
+    // Rows share storage, copies do not.
+    m.Row(0)[0] = 9
+    c := m.Copy()
+    c.Set(0, 0, 0)
+    if m.At(0, 0) != 9 {
+        t.Errorf("m.At(0, 0) == %v, want %v", m.At(0, 0), 9)
+    }
+}
+
+func TestMultiply(t *testing.T) {
+    a := RowsFactory([][]float64{{1, 2}, {3, 4}, {5, 6}})
+    b := RowsFactory([][]float64{{1, 0, 2}, {0, 1, 3}})
+
+    if got := a.MulVec([]float64{1, -1}); !reflect.DeepEqual(got, []float64{-1, -1, -1}) {
+        t.Errorf("a.MulVec() == %v, want %v", got, []float64{-1, -1, -1})
+    }
+
+    want := [][]float64{{1, 2, 8}, {3, 4, 18}, {5, 6, 28}}
+    if got := a.Mul(b).Rows(); !reflect.DeepEqual(got, want) {
+        t.Errorf("a.Mul(b) == %v, want %v", got, want)
+    }
+
+    want = [][]float64{{1, 3, 5}, {2, 4, 6}}
+    if got := a.T().Rows(); !reflect.DeepEqual(got, want) {
+        t.Errorf("a.T() == %v, want %v", got, want)
+    }
+
+    if got := Dot([]float64{1, 2, 3}, []float64{4, 5, 6}); got != 32 {
+        t.Errorf("Dot() == %v, want %v", got, 32)
+    }
+
+    want = [][]float64{{3, 4}, {6, 8}}
+    if got := Outer([]float64{1, 2}, []float64{3, 4}).Rows(); !reflect.DeepEqual(got, want) {
+        t.Errorf("Outer() == %v, want %v", got, want)
+    }
+}
+
+func TestElementwise(t *testing.T) {
+    a := RowsFactory([][]float64{{1, 2}, {3, 4}})
+
+    tests := []struct {
+        name string
+        got Matrix
+        want [][]float64
+    }{
+        {"Add", a.Add(a), [][]float64{{2, 4}, {6, 8}}},
+        {"Sub", a.Sub(a), [][]float64{{0, 0}, {0, 0}}},
+        {"MulElem", a.MulElem(a), [][]float64{{1, 4}, {9, 16}}},
+        {"DivElem", a.DivElem(a), [][]float64{{1, 1}, {1, 1}}},
+        {"Scale", a.Scale(3), [][]float64{{3, 6}, {9, 12}}},
+        {"Apply", a.Apply(math.Sqrt).Apply(func (x float64) float64 { return x * x }), [][]float64{{1, 2}, {3, 4}}},
+        {"Add row", a.Add(RowFactory([]float64{10, 20})), [][]float64{{11, 22}, {13, 24}}},
+        {"Add column", a.Add(ColumnFactory([]float64{10, 20})), [][]float64{{11, 12}, {23, 24}}},
+        {"Mul scalar", a.MulElem(RowFactory([]float64{2})), [][]float64{{2, 4}, {6, 8}}},
+    }
+
+    for _, test := range tests {
+        got := test.got.Rows()
+        for i := range got {
+            for j := range got[i] {
+                if math.Abs(got[i][j] - test.want[i][j]) > 1e-12 {
+                    t.Errorf("%v == %v, want %v", test.name, got, test.want)
+                }
+            }
+        }
+    }
+
+    if !reflect.DeepEqual(a.Rows(), [][]float64{{1, 2}, {3, 4}}) {
+        t.Errorf("Operations changed a to %v", a.Rows())
+    }
+
+    if got := AddVec([]float64{1, 2}, []float64{3, 4}); !reflect.DeepEqual(got, []float64{4, 6}) {
+        t.Errorf("AddVec() == %v, want %v", got, []float64{4, 6})
+    }
+    if got := ScaleVec([]float64{1, 2}, -1); !reflect.DeepEqual(got, []float64{-1, -2}) {
+        t.Errorf("ScaleVec() == %v, want %v", got, []float64{-1, -2})
+    }
+}
+
+func TestReductions(t *testing.T) {
+    a := RowsFactory([][]float64{{1, -2, 3}, {4, 5, 6}})
+
+    if a.Sum() != 17 {
+        t.Errorf("a.Sum() == %v, want %v", a.Sum(), 17)
+    }
+    if math.Abs(a.Mean() - (17.0 / 6)) > 1e-12 {
+        t.Errorf("a.Mean() == %v, want %v", a.Mean(), 17.0 / 6)
+    }
+    if a.Max() != 6 || a.Min() != -2 {
+        t.Errorf("a.Max(), a.Min() == %v, %v, want %v, %v", a.Max(), a.Min(), 6, -2)
+    }
+    if !reflect.DeepEqual(a.SumRows(), []float64{2, 15}) {
+        t.Errorf("a.SumRows() == %v, want %v", a.SumRows(), []float64{2, 15})
+    }
+    if !reflect.DeepEqual(a.SumCols(), []float64{5, 3, 9}) {
+        t.Errorf("a.SumCols() == %v, want %v", a.SumCols(), []float64{5, 3, 9})
+    }
+    if MatrixFactory(0, 0, nil).Mean() != 0 {
+        t.Errorf("The mean of an empty Matrix should be 0")
+    }
+}
+
+func TestMismatch(t *testing.T) {
+    a := MatrixFactory(2, 3, nil)
+    b := MatrixFactory(2, 2, nil)
+
+    tests := []struct {
+        name string
+        f func ()
+    }{
+        {"Mul", func () { a.Mul(a) }},
+        {"MulVec", func () { a.MulVec([]float64{1}) }},
+        {"Add", func () { a.Add(b) }},
+        {"Dot", func () { Dot([]float64{1}, []float64{1, 2}) }},
+        {"AddVec", func () { AddVec([]float64{1}, []float64{1, 2}) }},
+        {"MatrixFactory", func () { MatrixFactory(2, 2, []float64{1}) }},
+        {"RowsFactory", func () { RowsFactory([][]float64{{1, 2}, {3}}) }},
+    }
+
+    for _, test := range tests {
+        func () {
+            defer func () {
+                if _, ok := recover().(*DimensionError); !ok {
+                    t.Errorf("%v of mismatched dimensions should panic with a *DimensionError", test.name)
+                }
+            }()
+            test.f()
+        }()
+    }
+}
+
+func TestDimensionError(t *testing.T) {
+    if err := CheckLength("test", 2, 2); err != nil {
+        t.Errorf("CheckLength(2, 2) == %v, want nil", err)
+    }
+
+    err := CheckLength("test", 3, 2)
+    if _, ok := err.(*DimensionError); !ok {
+        t.Fatalf("CheckLength(3, 2) == %v, want a *DimensionError", err)
+    }
+    if err.Error() != "test: want 3 values, got 2" {
+        t.Errorf("err.Error() == %q", err.Error())
+    }
+
+    err = &DimensionError{Op: "mat: Mul", Rows: 2, Cols: 3, OtherRows: 2, OtherCols: 2}
+    if err.Error() != "mat: Mul: 2x3 does not fit 2x2" {
+        t.Errorf("err.Error() == %q", err.Error())
+    }
+}
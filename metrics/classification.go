@@ -0,0 +1,307 @@
+package metrics
+
+import (
+    "fmt"
+    "math"
+    "sort"
+    "strings"
+    "github.com/josephdpurcell/go-neural-network/mat"
+)
+
+var (
+    ErrNaNLabel = fmt.Errorf("metrics: labels must not be NaN")
+)
+
+/**
+ * The fraction of predictions that match the actual labels.
+ *
+ * A *mat.DimensionError is returned when there are not as many predictions as
+ * labels.
+ */
+func Accuracy (predicted, actual []float64) (float64, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(predicted)); err != nil {
+        return 0, err
+    }
+    if (len(actual) == 0) {
+        return 0, nil
+    }
+    var correct int = 0
+    for i := 0; i < len(actual); i++ {
+        if (predicted[i] == actual[i]) {
+            correct++
+        }
+    }
+    return float64(correct) / float64(len(actual)), nil
+}
+
+/**
+ * Count true positives, false positives and false negatives for one label.
+ */
+func counts (predicted, actual []float64, positive float64) (int, int, int, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(predicted)); err != nil {
+        return 0, 0, 0, err
+    }
+    var tp, fp, fn int = 0, 0, 0
+    for i := 0; i < len(actual); i++ {
+        if (predicted[i] == positive && actual[i] == positive) {
+            tp++
+        } else if (predicted[i] == positive) {
+            fp++
+        } else if (actual[i] == positive) {
+            fn++
+        }
+    }
+    return tp, fp, fn, nil
+}
+
+/**
+ * Of everything predicted as positive, the fraction that really is. 0 when
+ * nothing was predicted as positive.
+ */
+func Precision (predicted, actual []float64, positive float64) (float64, error) {
+    tp, fp, _, err := counts(predicted, actual, positive)
+    if err != nil {
+        return 0, err
+    }
+    return rate(float64(tp), float64(tp + fp)), nil
+}
+
+/**
+ * Of everything that really is positive, the fraction predicted as such. 0
+ * when nothing is positive.
+ */
+func Recall (predicted, actual []float64, positive float64) (float64, error) {
+    tp, _, fn, err := counts(predicted, actual, positive)
+    if err != nil {
+        return 0, err
+    }
+    return rate(float64(tp), float64(tp + fn)), nil
+}
+
+/**
+ * The harmonic mean of precision and recall.
+ */
+func F1 (predicted, actual []float64, positive float64) (float64, error) {
+    tp, fp, fn, err := counts(predicted, actual, positive)
+    if err != nil {
+        return 0, err
+    }
+    p := rate(float64(tp), float64(tp + fp))
+    r := rate(float64(tp), float64(tp + fn))
+    if (p + r == 0) {
+        return 0, nil
+    }
+    return 2 * p * r / (p + r), nil
+}
+
+/**
+ * How far predicted probabilities of the positive label are from the actual
+ * labels, which are 1 for positive and 0 otherwise. Confident wrong answers
+ * cost the most.
+ */
+func LogLoss (probabilities, actual []float64) (float64, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(probabilities)); err != nil {
+        return 0, err
+    }
+    if (len(actual) == 0) {
+        return 0, nil
+    }
+    const epsilon float64 = 1e-15
+    var sum float64 = 0
+    for i := 0; i < len(actual); i++ {
+        p := math.Min(math.Max(probabilities[i], epsilon), 1 - epsilon)
+        sum = sum - ((actual[i] * math.Log(p)) + ((1 - actual[i]) * math.Log(1 - p)))
+    }
+    return sum / float64(len(actual)), nil
+}
+
+/**
+ * One point of a ROC curve: predicting positive for every score at or above
+ * Threshold gives these false and true positive rates.
+ */
+type ROCPoint struct {
+    Threshold float64
+    FalsePositiveRate float64
+    TruePositiveRate float64
+}
+
+/**
+ * The ROC curve of scores, e.g. from a Perceptron's Score, against the actual
+ * labels. Starts at (0, 0) with an infinite threshold and ends at (1, 1).
+ */
+func ROC (scores, actual []float64, positive float64) ([]ROCPoint, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(scores)); err != nil {
+        return nil, err
+    }
+    order := make([]int, len(scores))
+    for i := 0; i < len(order); i++ {
+        order[i] = i
+    }
+    sort.SliceStable(order, func (a, b int) bool {
+        return scores[order[a]] > scores[order[b]]
+    })
+
+    var positives, negatives float64 = 0, 0
+    for i := 0; i < len(actual); i++ {
+        if (actual[i] == positive) {
+            positives++
+        } else {
+            negatives++
+        }
+    }
+
+    points := []ROCPoint{{Threshold: math.Inf(1)}}
+    var tp, fp float64 = 0, 0
+    for k := 0; k < len(order); k++ {
+        i := order[k]
+        if (actual[i] == positive) {
+            tp++
+        } else {
+            fp++
+        }
+        // Samples with the same score cannot be told apart, so only add a
+        // point once all of them are counted.
+        if (k + 1 < len(order) && scores[order[k + 1]] == scores[i]) {
+            continue
+        }
+        points = append(points, ROCPoint{
+            Threshold: scores[i],
+            FalsePositiveRate: rate(fp, negatives),
+            TruePositiveRate: rate(tp, positives),
+        })
+    }
+    return points, nil
+}
+
+/**
+ * count / total, or 0 when there is nothing to count.
+ */
+func rate (count, total float64) float64 {
+    if (total == 0) {
+        return 0
+    }
+    return count / total
+}
+
+/**
+ * The area under a ROC curve: 1 for perfect scores, 0.5 for guessing.
+ */
+func AUC (points []ROCPoint) float64 {
+    var area float64 = 0
+    for i := 1; i < len(points); i++ {
+        width := points[i].FalsePositiveRate - points[i - 1].FalsePositiveRate
+        height := (points[i].TruePositiveRate + points[i - 1].TruePositiveRate) / 2
+        area = area + (width * height)
+    }
+    return area
+}
+
+/**
+ * Counts of each actual label against each predicted label.
+ *
+ * Counts[a][p] is how many samples of Labels[a] were predicted as Labels[p].
+ */
+type ConfusionMatrix struct {
+    Labels []float64
+    Counts [][]int
+}
+
+/**
+ * The number of samples of the actual label predicted as the predicted label.
+ */
+func (m ConfusionMatrix) Count (actual, predicted float64) int {
+    a := m.index(actual)
+    p := m.index(predicted)
+    if (a < 0 || p < 0) {
+        return 0
+    }
+    return m.Counts[a][p]
+}
+
+func (m ConfusionMatrix) index (label float64) int {
+    for i := 0; i < len(m.Labels); i++ {
+        if (m.Labels[i] == label) {
+            return i
+        }
+    }
+    return -1
+}
+
+/**
+ * Render the matrix as a table, with actual labels down the side and
+ * predicted labels across the top.
+ */
+func (m ConfusionMatrix) String () string {
+    cells := make([][]string, len(m.Labels) + 1)
+    cells[0] = []string{"actual\\predicted"}
+    for _, label := range m.Labels {
+        cells[0] = append(cells[0], fmt.Sprint(label))
+    }
+    for a := 0; a < len(m.Labels); a++ {
+        cells[a + 1] = []string{fmt.Sprint(m.Labels[a])}
+        for p := 0; p < len(m.Labels); p++ {
+            cells[a + 1] = append(cells[a + 1], fmt.Sprint(m.Counts[a][p]))
+        }
+    }
+
+    widths := make([]int, len(cells[0]))
+    for _, row := range cells {
+        for c, cell := range row {
+            if (len(cell) > widths[c]) {
+                widths[c] = len(cell)
+            }
+        }
+    }
+
+    var b strings.Builder
+    for _, row := range cells {
+        for c, cell := range row {
+            if (c > 0) {
+                b.WriteString("  ")
+            }
+            b.WriteString(strings.Repeat(" ", widths[c] - len(cell)))
+            b.WriteString(cell)
+        }
+        b.WriteString("\n")
+    }
+    return b.String()
+}
+
+/**
+ * Create the confusion matrix of predictions against actual labels. Labels
+ * are every value seen in either, in increasing order.
+ *
+ * A *mat.DimensionError is returned when there are not as many predictions as
+ * labels, and ErrNaNLabel when any of them is NaN.
+ */
+func ConfusionMatrixFactory (predicted, actual []float64) (ConfusionMatrix, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(predicted)); err != nil {
+        return ConfusionMatrix{}, err
+    }
+    seen := make(map[float64]bool)
+    var labels []float64
+    for _, values := range [][]float64{actual, predicted} {
+        for _, v := range values {
+            if (math.IsNaN(v)) {
+                return ConfusionMatrix{}, ErrNaNLabel
+            }
+            if (!seen[v]) {
+                seen[v] = true
+                labels = append(labels, v)
+            }
+        }
+    }
+    sort.Float64s(labels)
+
+    m := ConfusionMatrix{
+        Labels: labels,
+        Counts: make([][]int, len(labels)),
+    }
+    for i := 0; i < len(labels); i++ {
+        m.Counts[i] = make([]int, len(labels))
+    }
+    for i := 0; i < len(actual); i++ {
+        m.Counts[m.index(actual[i])][m.index(predicted[i])]++
+    }
+    return m, nil
+}
@@ -0,0 +1,143 @@
+package metrics
+
+import (
+    "math"
+    "testing"
+)
+
+var predicted = []float64{1, 1, -1, -1, 1, -1}
+var actual = []float64{1, -1, -1, 1, 1, -1}
+
+func TestAccuracy(t *testing.T) {
+    got, _ := Accuracy(predicted, actual)
+    if math.Abs(got - (4.0 / 6)) > 1e-12 {
+        t.Errorf("Accuracy() == %v, want %v", got, 4.0 / 6)
+    }
+
+    if got, _ := Accuracy(nil, nil); got != 0 {
+        t.Errorf("Accuracy(nil, nil) == %v, want %v", got, 0)
+    }
+}
+
+func TestPrecisionRecallF1(t *testing.T) {
+    // 2 true positives, 1 false positive, 1 false negative.
+    tests := []struct {
+        name string
+        metric func (predicted, actual []float64, positive float64) (float64, error)
+        predicted []float64
+        actual []float64
+        want float64
+    }{
+        {"Precision", Precision, predicted, actual, 2.0 / 3},
+        {"Recall", Recall, predicted, actual, 2.0 / 3},
+        {"F1", F1, predicted, actual, 2.0 / 3},
+        {"Precision with no positive predictions", Precision, []float64{0, 0}, []float64{1, 0}, 0},
+        {"Recall with no positives", Recall, []float64{1, 0}, []float64{0, 0}, 0},
+        {"F1 with nothing right", F1, []float64{1, 0}, []float64{0, 1}, 0},
+    }
+
+    for _, test := range tests {
+        got, err := test.metric(test.predicted, test.actual, 1)
+        if err != nil || math.Abs(got - test.want) > 1e-12 {
+            t.Errorf("%v == %v, %v, want %v", test.name, got, err, test.want)
+        }
+    }
+}
+
+func TestLogLoss(t *testing.T) {
+    got, _ := LogLoss([]float64{0.5, 0.5}, []float64{1, 0})
+    if math.Abs(got - math.Log(2)) > 1e-12 {
+        t.Errorf("LogLoss({0.5, 0.5}, {1, 0}) == %v, want %v", got, math.Log(2))
+    }
+
+    got, _ = LogLoss([]float64{0}, []float64{1})
+    if math.IsInf(got, 0) || got < 30 {
+        t.Errorf("LogLoss({0}, {1}) == %v, want a large but finite loss", got)
+    }
+}
+
+func TestROC(t *testing.T) {
+    // Perfectly ranked scores.
+    points, _ := ROC([]float64{0.9, 0.8, 0.3, 0.1}, []float64{1, 1, -1, -1}, 1)
+    if got := AUC(points); got != 1 {
+        t.Errorf("AUC of perfect scores == %v, want %v", got, 1)
+    }
+    last := points[len(points) - 1]
+    if points[0].FalsePositiveRate != 0 || points[0].TruePositiveRate != 0 || last.FalsePositiveRate != 1 || last.TruePositiveRate != 1 {
+        t.Errorf("ROC should run from (0, 0) to (1, 1), but is %v", points)
+    }
+
+    // Backwards scores.
+    points, _ = ROC([]float64{0.1, 0.2, 0.8, 0.9}, []float64{1, 1, -1, -1}, 1)
+    if got := AUC(points); got != 0 {
+        t.Errorf("AUC of backwards scores == %v, want %v", got, 0)
+    }
+
+    // Every score tied is no better than guessing.
+    points, _ = ROC([]float64{0.5, 0.5, 0.5, 0.5}, []float64{1, -1, 1, -1}, 1)
+    if len(points) != 2 {
+        t.Errorf("Tied scores should give a single step, but gave %v", points)
+    }
+    if got := AUC(points); got != 0.5 {
+        t.Errorf("AUC of tied scores == %v, want %v", got, 0.5)
+    }
+}
+
+func TestConfusionMatrix(t *testing.T) {
+    m, err := ConfusionMatrixFactory(predicted, actual)
+    if err != nil {
+        t.Fatalf("ConfusionMatrixFactory() returned %v", err)
+    }
+
+    if len(m.Labels) != 2 || m.Labels[0] != -1 || m.Labels[1] != 1 {
+        t.Fatalf("Labels == %v, want %v", m.Labels, []float64{-1, 1})
+    }
+
+    tests := []struct {
+        actual float64
+        predicted float64
+        want int
+    }{
+        {1, 1, 2},
+        {1, -1, 1},
+        {-1, 1, 1},
+        {-1, -1, 2},
+        {0, 0, 0},
+    }
+    for _, test := range tests {
+        if got := m.Count(test.actual, test.predicted); got != test.want {
+            t.Errorf("m.Count(%v, %v) == %v, want %v", test.actual, test.predicted, got, test.want)
+        }
+    }
+
+    want := "actual\\predicted  -1  1\n" +
+        "              -1   2  1\n" +
+        "               1   1  2\n"
+    if m.String() != want {
+        t.Errorf("m.String() ==\n%v\nwant\n%v", m.String(), want)
+    }
+}
+
+func TestClassificationInvalid(t *testing.T) {
+    short := []float64{1, -1}
+    long := []float64{1, -1, 1}
+
+    if _, err := Accuracy(short, long); err == nil {
+        t.Errorf("Accuracy() of 2 predictions and 3 labels should return an error")
+    }
+    if _, err := F1(long, short, 1); err == nil {
+        t.Errorf("F1() of 3 predictions and 2 labels should return an error")
+    }
+    if _, err := LogLoss(short, long); err == nil {
+        t.Errorf("LogLoss() of 2 probabilities and 3 labels should return an error")
+    }
+    if _, err := ROC(short, long, 1); err == nil {
+        t.Errorf("ROC() of 2 scores and 3 labels should return an error")
+    }
+    if _, err := ConfusionMatrixFactory(short, long); err == nil {
+        t.Errorf("ConfusionMatrixFactory() of 2 predictions and 3 labels should return an error")
+    }
+    if _, err := ConfusionMatrixFactory([]float64{1, math.NaN()}, short); err != ErrNaNLabel {
+        t.Errorf("ConfusionMatrixFactory() with a NaN prediction returned %v, want %v", err, ErrNaNLabel)
+    }
+}
@@ -0,0 +1,195 @@
+package metrics
+
+import (
+    "math"
+    "github.com/josephdpurcell/go-neural-network/mat"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+)
+
+/**
+ * The mean of the squared differences between predictions and actual values.
+ *
+ * Like every regression metric, a *mat.DimensionError is returned when there
+ * are not as many predictions as actual values.
+ */
+func MSE (predicted, actual []float64) (float64, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(predicted)); err != nil {
+        return 0, err
+    }
+    if (len(actual) == 0) {
+        return 0, nil
+    }
+    var sum float64 = 0
+    for i := 0; i < len(actual); i++ {
+        d := predicted[i] - actual[i]
+        sum = sum + (d * d)
+    }
+    return sum / float64(len(actual)), nil
+}
+
+/**
+ * The square root of MSE, in the same units as the values.
+ */
+func RMSE (predicted, actual []float64) (float64, error) {
+    mse, err := MSE(predicted, actual)
+    if err != nil {
+        return 0, err
+    }
+    return math.Sqrt(mse), nil
+}
+
+/**
+ * The mean of the absolute differences between predictions and actual values.
+ */
+func MAE (predicted, actual []float64) (float64, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(predicted)); err != nil {
+        return 0, err
+    }
+    if (len(actual) == 0) {
+        return 0, nil
+    }
+    var sum float64 = 0
+    for i := 0; i < len(actual); i++ {
+        sum = sum + math.Abs(predicted[i] - actual[i])
+    }
+    return sum / float64(len(actual)), nil
+}
+
+/**
+ * The coefficient of determination: 1 for perfect predictions, 0 for always
+ * predicting the mean, and negative for anything worse than that.
+ *
+ * When every actual value is the same it is 1 for perfect predictions and 0
+ * otherwise.
+ */
+func R2 (predicted, actual []float64) (float64, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(predicted)); err != nil {
+        return 0, err
+    }
+    if (len(actual) == 0) {
+        return 0, nil
+    }
+    var mean float64 = 0
+    for i := 0; i < len(actual); i++ {
+        mean = mean + actual[i]
+    }
+    mean = mean / float64(len(actual))
+
+    var residual, total float64 = 0, 0
+    for i := 0; i < len(actual); i++ {
+        residual = residual + math.Pow(actual[i] - predicted[i], 2)
+        total = total + math.Pow(actual[i] - mean, 2)
+    }
+    if (total == 0) {
+        if (residual == 0) {
+            return 1, nil
+        }
+        return 0, nil
+    }
+    return 1 - (residual / total), nil
+}
+
+/**
+ * Every regression metric for one set of predictions.
+ */
+type Regression struct {
+    MSE float64
+    RMSE float64
+    MAE float64
+    R2 float64
+}
+
+/**
+ * Compute every regression metric of predictions against actual values.
+ */
+func RegressionFactory (predicted, actual []float64) (Regression, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(predicted)); err != nil {
+        return Regression{}, err
+    }
+    mse, _ := MSE(predicted, actual)
+    mae, _ := MAE(predicted, actual)
+    r2, _ := R2(predicted, actual)
+    r := Regression{
+        MSE: mse,
+        RMSE: math.Sqrt(mse),
+        MAE: mae,
+        R2: r2,
+    }
+    return r, nil
+}
+
+/**
+ * Compute the regression metrics of each output of a model separately. Each
+ * row is one sample, so the result has one entry per column.
+ *
+ * A *mat.DimensionError is returned unless there are as many predicted rows
+ * as actual ones and every row is as long as the first actual one.
+ */
+func PerDimension (predicted, actual [][]float64) ([]Regression, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(predicted)); err != nil {
+        return nil, err
+    }
+    if (len(actual) == 0) {
+        return nil, nil
+    }
+    for i := 0; i < len(actual); i++ {
+        if err := mat.CheckLength("metrics: actual row", len(actual[0]), len(actual[i])); err != nil {
+            return nil, err
+        }
+        if err := mat.CheckLength("metrics: predicted row", len(actual[0]), len(predicted[i])); err != nil {
+            return nil, err
+        }
+    }
+    results := make([]Regression, len(actual[0]))
+    p := make([]float64, len(actual))
+    a := make([]float64, len(actual))
+    for d := 0; d < len(results); d++ {
+        for i := 0; i < len(actual); i++ {
+            p[i] = predicted[i][d]
+            a[i] = actual[i][d]
+        }
+        results[d], _ = RegressionFactory(p, a)
+    }
+    return results, nil
+}
+
+/**
+ * Compute the regression metrics of vector predictions, such as a
+ * perceptronMover's, for X and Y separately. Every metric is 0 when there are
+ * no vectors.
+ */
+func Vectors (predicted, actual []pvector.PVector) (x Regression, y Regression, err error) {
+    p := make([][]float64, len(predicted))
+    for i := 0; i < len(predicted); i++ {
+        p[i] = []float64{predicted[i].X, predicted[i].Y}
+    }
+    a := make([][]float64, len(actual))
+    for i := 0; i < len(actual); i++ {
+        a[i] = []float64{actual[i].X, actual[i].Y}
+    }
+    results, err := PerDimension(p, a)
+    if err != nil {
+        return Regression{}, Regression{}, err
+    }
+    if (len(actual) == 0) {
+        return Regression{}, Regression{}, nil
+    }
+    return results[0], results[1], nil
+}
+
+/**
+ * The mean distance between predicted and actual vectors.
+ */
+func MeanDistance (predicted, actual []pvector.PVector) (float64, error) {
+    if err := mat.CheckLength("metrics", len(actual), len(predicted)); err != nil {
+        return 0, err
+    }
+    if (len(actual) == 0) {
+        return 0, nil
+    }
+    var sum float64 = 0
+    for i := 0; i < len(actual); i++ {
+        sum = sum + predicted[i].Sub(actual[i]).Mag()
+    }
+    return sum / float64(len(actual)), nil
+}
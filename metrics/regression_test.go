@@ -0,0 +1,115 @@
+package metrics
+
+import (
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+)
+
+func TestRegression(t *testing.T) {
+    predicted := []float64{2, 4, 5, 9}
+    actual := []float64{1, 4, 7, 8}
+
+    // Errors are 1, 0, -2, 1 and the actual mean is 5.
+    tests := []struct {
+        name string
+        metric func (predicted, actual []float64) (float64, error)
+        predicted []float64
+        actual []float64
+        want float64
+    }{
+        {"MSE", MSE, predicted, actual, 1.5},
+        {"RMSE", RMSE, predicted, actual, math.Sqrt(1.5)},
+        {"MAE", MAE, predicted, actual, 1},
+        {"R2", R2, predicted, actual, 1 - (6.0 / 30)},
+        {"R2 of perfect predictions", R2, actual, actual, 1},
+        {"R2 of predicting the mean", R2, []float64{5, 5, 5, 5}, actual, 0},
+        {"R2 of constant actual values", R2, []float64{1, 2}, []float64{2, 2}, 0},
+        {"R2 of perfect constant predictions", R2, []float64{2, 2}, []float64{2, 2}, 1},
+        {"MSE of nothing", MSE, nil, nil, 0},
+    }
+
+    for _, test := range tests {
+        got, err := test.metric(test.predicted, test.actual)
+        if err != nil || math.Abs(got - test.want) > 1e-12 {
+            t.Errorf("%v == %v, %v, want %v", test.name, got, err, test.want)
+        }
+    }
+
+    r, err := RegressionFactory(predicted, actual)
+    if err != nil || r.MSE != 1.5 || r.RMSE != math.Sqrt(1.5) || r.MAE != 1 || math.Abs(r.R2 - (1 - (6.0 / 30))) > 1e-12 {
+        t.Errorf("RegressionFactory() == %v, %v, but the metrics disagree", r, err)
+    }
+}
+
+func TestPerDimension(t *testing.T) {
+    predicted := [][]float64{{1, 10}, {2, 20}}
+    actual := [][]float64{{1, 12}, {2, 16}}
+
+    results, err := PerDimension(predicted, actual)
+    if err != nil {
+        t.Fatalf("PerDimension() returned %v", err)
+    }
+    if len(results) != 2 {
+        t.Fatalf("PerDimension() returned %v results, want %v", len(results), 2)
+    }
+    if results[0].MSE != 0 {
+        t.Errorf("PerDimension()[0].MSE == %v, want %v", results[0].MSE, 0)
+    }
+    if results[1].MSE != 10 {
+        t.Errorf("PerDimension()[1].MSE == %v, want %v", results[1].MSE, 10)
+    }
+}
+
+func TestVectors(t *testing.T) {
+    predicted := []pvector.PVector{pvector.PVectorFactory(0, 3), pvector.PVectorFactory(1, 1)}
+    actual := []pvector.PVector{pvector.PVectorFactory(4, 0), pvector.PVectorFactory(1, 1)}
+
+    x, y, err := Vectors(predicted, actual)
+    if err != nil {
+        t.Fatalf("Vectors() returned %v", err)
+    }
+    if x.MAE != 2 {
+        t.Errorf("X MAE == %v, want %v", x.MAE, 2)
+    }
+    if y.MAE != 1.5 {
+        t.Errorf("Y MAE == %v, want %v", y.MAE, 1.5)
+    }
+
+    if x, y, err := Vectors(nil, nil); err != nil || x != (Regression{}) || y != (Regression{}) {
+        t.Errorf("Vectors(nil, nil) == %v, %v, %v, want zero metrics", x, y, err)
+    }
+
+    if got, _ := MeanDistance(predicted, actual); got != 2.5 {
+        t.Errorf("MeanDistance() == %v, want %v", got, 2.5)
+    }
+}
+
+func TestRegressionInvalid(t *testing.T) {
+    short := []float64{1, 2}
+    long := []float64{1, 2, 3}
+
+    metrics := map[string]func (predicted, actual []float64) (float64, error){
+        "MSE": MSE,
+        "RMSE": RMSE,
+        "MAE": MAE,
+        "R2": R2,
+    }
+    for name, metric := range metrics {
+        if _, err := metric(short, long); err == nil {
+            t.Errorf("%v() of 2 predictions and 3 values should return an error", name)
+        }
+    }
+    if _, err := RegressionFactory(long, short); err == nil {
+        t.Errorf("RegressionFactory() of 3 predictions and 2 values should return an error")
+    }
+    if _, err := PerDimension([][]float64{{1, 2}, {3}}, [][]float64{{1, 2}, {3, 4}}); err == nil {
+        t.Errorf("PerDimension() with a short predicted row should return an error")
+    }
+    if _, _, err := Vectors([]pvector.PVector{pvector.PVectorFactory(0, 0)}, nil); err == nil {
+        t.Errorf("Vectors() of 1 prediction and no values should return an error")
+    }
+    if _, err := MeanDistance(nil, []pvector.PVector{pvector.PVectorFactory(0, 0)}); err == nil {
+        t.Errorf("MeanDistance() of no predictions and 1 value should return an error")
+    }
+}
@@ -2,6 +2,8 @@ package mover
 
 import (
     "fmt"
+    "github.com/josephdpurcell/go-neural-network/event"
+    "github.com/josephdpurcell/go-neural-network/forces"
     "github.com/josephdpurcell/go-neural-network/pvector"
     "github.com/josephdpurcell/go-neural-network/perceptronMover"
 )
@@ -17,29 +19,48 @@ type Mover struct {
     maxspeed float64
     maxforce float64
     mass float64
+    objective Objective
+    attached []forces.Force
+    observer event.Observer
 }
 
 /**
  * Move the object based on acceleration and velocity.
  *
- * With every iteration, we add the acceleration to the velocity, limit the
- * velocity to its max speed, then move the Mover the distance based on
- * velocity. Finally, we set acceleration to 0 to allow re-computation of the
- * steering force.
+ * With every iteration, we apply every attached force, add the acceleration to
+ * the velocity, limit the velocity to its max speed, then move the Mover the
+ * distance based on velocity. Finally, we set acceleration to 0 to allow
+ * re-computation of the steering force.
+ *
+ * A Mover without mass, e.g. a zero-value one, cannot have forces attached;
+ * the error from ApplyForce is returned and the Mover does not move.
  */
-func (m *Mover) Update () {
+func (m *Mover) Update () error {
+    for _, f := range m.attached {
+        if err := m.ApplyForce(f.Force(m)); err != nil {
+            return err
+        }
+    }
     m.velocity = m.velocity.Add(m.acceleration)
     m.velocity = m.velocity.Limit(m.maxspeed)
     m.location = m.location.Add(m.velocity)
     m.acceleration = m.acceleration.Mult(0)
+    return nil
 }
 
 /**
- * Apply the given force on the mover.
+ * Apply the given force on the mover, until the next Update.
+ *
+ * ErrInvalidMass is returned, and nothing changes, when the Mover has no
+ * mass to divide the force by.
  */
-func (m *Mover) applyForce (f pvector.PVector) {
-    f = f.Div(m.mass)
-    m.acceleration = m.acceleration.Add(f)
+func (m *Mover) ApplyForce (f pvector.PVector) error {
+    a, err := f.CheckedDiv(m.mass)
+    if err != nil {
+        return ErrInvalidMass
+    }
+    m.acceleration = m.acceleration.Add(a)
+    return nil
 }
 
 /**
@@ -68,54 +89,156 @@ func (m Mover) getSteeringForce (target pvector.PVector) pvector.PVector {
     steer = desired.Sub(m.velocity)
     //steer = steer.Sub(m.acceleration)
     steer = steer.Mult(m.mass)
-    fmt.Printf("STEER: %v", steer)
-    fmt.Println()
-    steer = steer.Limit(m.maxforce)
-    fmt.Printf("STEER: %v", steer.Div(m.mass))
-    fmt.Println()
+    limited := steer.Limit(m.maxforce)
 
-    return steer
+    event.Notify(m.observer, event.Steer{
+        Target: target,
+        Desired: steer,
+        Force: limited,
+        Mass: m.mass,
+    })
+
+    return limited
 }
 
 /**
  * Move the object toward the given targets.
+ *
+ * The brain needs exactly one target per force it weighs, and the objective
+ * has to find something to head towards among them. Otherwise the Mover is
+ * left alone and the error is returned.
  */
-func (m *Mover) Seek (targets []pvector.PVector) {
+func (m *Mover) Seek (targets []pvector.PVector) error {
     // Gather forces.
-    var forces = make([]pvector.PVector, len(targets))
+    var steering = make([]pvector.PVector, len(targets))
     for i := 0; i < len(targets); i++ {
-        forces[i] = m.getSteeringForce(targets[i])
+        steering[i] = m.getSteeringForce(targets[i])
     }
 
     // Compute the steering force and apply.
-    output := m.brain.Feedforward(forces)
-    m.applyForce(output)
+    output, err := m.brain.Feedforward(steering)
+    if err != nil {
+        return fmt.Errorf("mover: %w", err)
+    }
+    desired, err := m.objective(m.location, targets)
+    if err != nil {
+        return err
+    }
+    if err := m.ApplyForce(output); err != nil {
+        return err
+    }
 
-    // Train the brain to go towards a specific one.
-    desired := pvector.PVectorFactory(209, 215)
+    // Train the brain to go towards the one the objective picks, using the
+    // same forces it was just given.
     error := desired.Sub(m.location)
-    fmt.Printf("LOC: %v", m.location)
-    fmt.Println()
-    fmt.Printf("DES: %v", desired)
-    fmt.Println()
-    fmt.Printf("ERROR: %v", error)
-    fmt.Println()
-    m.brain.Train(forces, error)
+    event.Notify(m.observer, event.Seek{
+        Location: m.location,
+        Desired: desired,
+        Error: error,
+    })
+    return m.brain.Train(steering, error)
+}
+
+/**
+ * Apply a force from the environment, e.g. forces.Gravity, on every Update
+ * from now on.
+ */
+func (m *Mover) Attach (f forces.Force) {
+    m.attached = append(m.attached, f)
+}
+
+/**
+ * Change what the Mover learns to head towards, e.g. Target(1) or
+ * Reward(Closest). Takes effect from the next Seek. ErrNilObjective is
+ * returned, and nothing changes, when o is nil.
+ */
+func (m *Mover) SetObjective (o Objective) error {
+    if (o == nil) {
+        return ErrNilObjective
+    }
+    m.objective = o
+    return nil
+}
+
+/**
+ * Report steering and seeking to the observer, or to nobody when nil.
+ */
+func (m *Mover) SetObserver (o event.Observer) {
+    m.observer = o
+}
+
+/**
+ * Where the Mover is.
+ */
+func (m Mover) Location () pvector.PVector {
+    return m.location
+}
+
+/**
+ * How fast and in which direction the Mover is going.
+ */
+func (m Mover) Velocity () pvector.PVector {
+    return m.velocity
+}
+
+/**
+ * The forces applied to the Mover since it last moved, divided by its mass.
+ */
+func (m Mover) Acceleration () pvector.PVector {
+    return m.acceleration
+}
+
+/**
+ * How heavy the Mover is.
+ */
+func (m Mover) Mass () float64 {
+    return m.mass
 }
 
 /**
  * The means of creating a Mover.
+ *
+ * The Mover learns to head towards the first of the targets it seeks, until
+ * SetObjective says otherwise. Options change anything else, e.g.
+ * WithMass(10). An invalid option gives one of the ErrInvalid errors of this
+ * package or of perceptronMover.
  */
-func MoverFactory (location, velocity, acceleration pvector.PVector) Mover {
+func MoverFactory (location, velocity, acceleration pvector.PVector, opts ...Option) (Mover, error) {
+    o := options{
+        maxspeed: 20,
+        maxforce: 2000,
+        mass: 100,
+        inputs: 2,
+        learning: 0.00001,
+        objective: Target(0),
+    }
+    for _, opt := range opts {
+        opt(&o)
+    }
+    if err := o.check(); err != nil {
+        return Mover{}, err
+    }
+
+    var brain perceptronMover.Perceptron
+    if (o.brain != nil) {
+        brain = o.brain.Copy()
+    } else {
+        var err error
+        brain, err = perceptronMover.PerceptronFactory(o.inputs, o.learning)
+        if err != nil {
+            return Mover{}, err
+        }
+    }
+
     m := Mover{
-        brain: perceptronMover.PerceptronFactory(2, 0.00001),
+        brain: brain,
         location: location,
         velocity: velocity,
         acceleration: acceleration,
-        maxspeed: 20,
-        maxforce: 2000,
-        mass: 100,
+        maxspeed: o.maxspeed,
+        maxforce: o.maxforce,
+        mass: o.mass,
+        objective: o.objective,
     }
-    return m
+    return m, nil
 }
-
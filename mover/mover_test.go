@@ -1,7 +1,12 @@
 package mover
 
 import (
+    "errors"
     "testing"
+    "github.com/josephdpurcell/go-neural-network/event"
+    "github.com/josephdpurcell/go-neural-network/forces"
+    "github.com/josephdpurcell/go-neural-network/mat"
+    "github.com/josephdpurcell/go-neural-network/perceptronMover"
     "github.com/josephdpurcell/go-neural-network/pvector"
 )
 
@@ -10,7 +15,7 @@ func TestMoverFactory(t *testing.T) {
     velocity := pvector.PVectorFactory(0, 0)
     acceleration := pvector.PVectorFactory(0, 0)
 
-    m := MoverFactory(location, velocity, acceleration)
+    m, _ := MoverFactory(location, velocity, acceleration)
 
     if m.location != location {
         t.Errorf("Location should have been %v, but was %v", location, m.location)
@@ -25,3 +30,192 @@ func TestMoverFactory(t *testing.T) {
     }
 }
 
+func TestMoverAccessors(t *testing.T) {
+    m, _ := MoverFactory(pvector.PVectorFactory(1, 2), pvector.PVectorFactory(3, 4), pvector.PVectorFactory(5, 6))
+
+    if m.Location() != pvector.PVectorFactory(1, 2) {
+        t.Errorf("m.Location() == %v, want %v", m.Location(), pvector.PVectorFactory(1, 2))
+    }
+    if m.Velocity() != pvector.PVectorFactory(3, 4) {
+        t.Errorf("m.Velocity() == %v, want %v", m.Velocity(), pvector.PVectorFactory(3, 4))
+    }
+    if m.Acceleration() != pvector.PVectorFactory(5, 6) {
+        t.Errorf("m.Acceleration() == %v, want %v", m.Acceleration(), pvector.PVectorFactory(5, 6))
+    }
+
+    m.Update()
+    if m.Location() != pvector.PVectorFactory(9, 12) || m.Acceleration() != pvector.PVectorFactory(0, 0) {
+        t.Errorf("After m.Update(), location is %v and acceleration %v", m.Location(), m.Acceleration())
+    }
+}
+
+func TestMoverSeekObserver(t *testing.T) {
+    location := pvector.PVectorFactory(100, 100)
+    velocity := pvector.PVectorFactory(0, 0)
+    acceleration := pvector.PVectorFactory(0, 0)
+
+    m, _ := MoverFactory(location, velocity, acceleration)
+
+    var kinds []string
+    m.SetObserver(func (e event.Event) {
+        kinds = append(kinds, e.Kind())
+    })
+
+    targets := []pvector.PVector{pvector.PVectorFactory(209, 215), pvector.PVectorFactory(400, 400)}
+    if err := m.Seek(targets); err != nil {
+        t.Fatalf("m.Seek(%v) returned %v", targets, err)
+    }
+
+    want := []string{"steer", "steer", "seek"}
+    if len(kinds) != len(want) {
+        t.Fatalf("Observer saw %v, want %v", kinds, want)
+    }
+    for i := 0; i < len(want); i++ {
+        if kinds[i] != want[i] {
+            t.Errorf("Observer saw %v, want %v", kinds, want)
+        }
+    }
+}
+
+func TestMoverSeekWrongTargetCount(t *testing.T) {
+    location := pvector.PVectorFactory(100, 100)
+    m, _ := MoverFactory(location, pvector.PVectorFactory(0, 0), pvector.PVectorFactory(0, 0))
+
+    targets := []pvector.PVector{pvector.PVectorFactory(209, 215)}
+    var dimension *mat.DimensionError
+    if err := m.Seek(targets); !errors.As(err, &dimension) {
+        t.Errorf("m.Seek(%v) error == %v, want a *mat.DimensionError", targets, err)
+    }
+    m.Update()
+    if m.location != location {
+        t.Errorf("A failed Seek should not move the Mover, but it moved to %v", m.location)
+    }
+}
+
+func TestMoverSeekTrainsOnSteeringForces(t *testing.T) {
+    m, _ := MoverFactory(pvector.PVectorFactory(100, 100), pvector.PVectorFactory(0, 0), pvector.PVectorFactory(0, 0))
+    expected, _ := perceptronMover.PerceptronFactory(2, 0.00001)
+    targets := []pvector.PVector{pvector.PVectorFactory(209, 215), pvector.PVectorFactory(400, 400)}
+    ones := []pvector.PVector{pvector.PVectorFactory(1, 1), pvector.PVectorFactory(1, 1)}
+
+    // The weights only start to matter after the first step, so take a few.
+    for step := 0; step < 3; step++ {
+        steering := []pvector.PVector{m.getSteeringForce(targets[0]), m.getSteeringForce(targets[1])}
+        expected.Train(steering, targets[0].Sub(m.location))
+
+        if err := m.Seek(targets); err != nil {
+            t.Fatalf("m.Seek(%v) returned %v", targets, err)
+        }
+        m.Update()
+
+        got, _ := m.brain.Weigh(ones)
+        want, _ := expected.Weigh(ones)
+        if got[0] != want[0] || got[1] != want[1] {
+            t.Fatalf("Step %v: brain weights are %v, want %v", step, got, want)
+        }
+    }
+}
+
+func TestMoverSetObjective(t *testing.T) {
+    m, _ := MoverFactory(pvector.PVectorFactory(100, 100), pvector.PVectorFactory(0, 0), pvector.PVectorFactory(0, 0))
+    targets := []pvector.PVector{pvector.PVectorFactory(209, 215), pvector.PVectorFactory(120, 90)}
+
+    var desired []pvector.PVector
+    m.SetObserver(func (e event.Event) {
+        if s, ok := e.(event.Seek); ok {
+            desired = append(desired, s.Desired)
+        }
+    })
+
+    m.Seek(targets)
+    m.SetObjective(Target(1))
+    m.Seek(targets)
+    m.SetObjective(Reward(Closest))
+    m.Seek(targets)
+    m.SetObjective(Point(pvector.PVectorFactory(1, 2)))
+    m.Seek(targets)
+
+    want := []pvector.PVector{targets[0], targets[1], targets[1], pvector.PVectorFactory(1, 2)}
+    if len(desired) != len(want) {
+        t.Fatalf("Seek events wanted %v, want %v", desired, want)
+    }
+    for i := 0; i < len(want); i++ {
+        if desired[i] != want[i] {
+            t.Errorf("Seek %v wanted %v, want %v", i, desired[i], want[i])
+        }
+    }
+}
+
+func TestMoverSeekNoTarget(t *testing.T) {
+    location := pvector.PVectorFactory(100, 100)
+    m, _ := MoverFactory(location, pvector.PVectorFactory(0, 0), pvector.PVectorFactory(0, 0), WithObjective(Target(2)))
+
+    targets := []pvector.PVector{pvector.PVectorFactory(209, 215), pvector.PVectorFactory(120, 90)}
+    if err := m.Seek(targets); err != ErrNoTarget {
+        t.Errorf("m.Seek(%v) with Target(2) error == %v, want %v", targets, err, ErrNoTarget)
+    }
+    m.Update()
+    if m.location != location {
+        t.Errorf("A failed Seek should not move the Mover, but it moved to %v", m.location)
+    }
+
+    if err := m.SetObjective(nil); err != ErrNilObjective {
+        t.Errorf("m.SetObjective(nil) error == %v, want %v", err, ErrNilObjective)
+    }
+    if err := m.Seek(targets); err != ErrNoTarget {
+        t.Errorf("m.SetObjective(nil) should keep Target(2), but m.Seek() returned %v", err)
+    }
+}
+
+func TestMoverApplyForce(t *testing.T) {
+    m, _ := MoverFactory(pvector.PVectorFactory(0, 0), pvector.PVectorFactory(0, 0), pvector.PVectorFactory(0, 0), WithMass(2))
+
+    m.ApplyForce(pvector.PVectorFactory(4, 0))
+    m.ApplyForce(pvector.PVectorFactory(0, -2))
+    if m.Acceleration() != pvector.PVectorFactory(2, -1) {
+        t.Errorf("m.Acceleration() == %v, want %v", m.Acceleration(), pvector.PVectorFactory(2, -1))
+    }
+
+    m.Update()
+    if m.Location() != pvector.PVectorFactory(2, -1) {
+        t.Errorf("m.Location() == %v, want %v", m.Location(), pvector.PVectorFactory(2, -1))
+    }
+}
+
+func TestMoverAttach(t *testing.T) {
+    m, _ := MoverFactory(pvector.PVectorFactory(0, 0), pvector.PVectorFactory(0, 0), pvector.PVectorFactory(0, 0), WithMass(2))
+    var _ forces.Body = m
+
+    m.Attach(forces.Gravity{Acceleration: pvector.PVectorFactory(0, -1)})
+    m.Attach(forces.Wind{Push: pvector.PVectorFactory(1, 0)})
+
+    // Attached forces keep applying every Update.
+    m.Update()
+    m.Update()
+    if m.Velocity() != pvector.PVectorFactory(1, -2) {
+        t.Errorf("m.Velocity() == %v, want %v", m.Velocity(), pvector.PVectorFactory(1, -2))
+    }
+    if m.Location() != pvector.PVectorFactory(1.5, -3) {
+        t.Errorf("m.Location() == %v, want %v", m.Location(), pvector.PVectorFactory(1.5, -3))
+    }
+}
+
+func TestMoverWithoutMass(t *testing.T) {
+    var m Mover
+    if err := m.ApplyForce(pvector.PVectorFactory(1, 0)); err != ErrInvalidMass {
+        t.Errorf("m.ApplyForce() without mass error == %v, want %v", err, ErrInvalidMass)
+    }
+    if m.Acceleration() != pvector.PVectorFactory(0, 0) {
+        t.Errorf("A failed ApplyForce changed the acceleration to %v", m.Acceleration())
+    }
+
+    m.velocity = pvector.PVectorFactory(1, 1)
+    m.maxspeed = 10
+    m.Attach(forces.Wind{Push: pvector.PVectorFactory(1, 0)})
+    if err := m.Update(); err != ErrInvalidMass {
+        t.Errorf("m.Update() without mass error == %v, want %v", err, ErrInvalidMass)
+    }
+    if m.Location() != pvector.PVectorFactory(0, 0) {
+        t.Errorf("A failed Update should not move the Mover, but it moved to %v", m.Location())
+    }
+}
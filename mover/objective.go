@@ -0,0 +1,71 @@
+package mover
+
+import (
+    "fmt"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+)
+
+var (
+    ErrNoTarget = fmt.Errorf("mover: no such target to head towards")
+    ErrNilObjective = fmt.Errorf("mover: objective must not be nil")
+)
+
+/**
+ * Decides what a Mover learns to head towards: given where the Mover is and
+ * the targets it is seeking, the point it should have gone to, or an error
+ * when there is no such point.
+ *
+ * Any func will do as a callback, or use one of the objectives below.
+ */
+type Objective func (location pvector.PVector, targets []pvector.PVector) (pvector.PVector, error)
+
+/**
+ * Learn to head towards the target at index i of those being sought.
+ * ErrNoTarget is returned when there are not that many targets.
+ */
+func Target (i int) Objective {
+    return func (location pvector.PVector, targets []pvector.PVector) (pvector.PVector, error) {
+        if (i < 0 || i >= len(targets)) {
+            return pvector.PVector{}, ErrNoTarget
+        }
+        return targets[i], nil
+    }
+}
+
+/**
+ * Learn to head towards a fixed point, whatever the targets are.
+ */
+func Point (p pvector.PVector) Objective {
+    return func (location pvector.PVector, targets []pvector.PVector) (pvector.PVector, error) {
+        return p, nil
+    }
+}
+
+/**
+ * Learn to head towards whichever target the reward function scores highest
+ * from where the Mover is now. The first target wins a tie. ErrNoTarget is
+ * returned when there are no targets.
+ */
+func Reward (reward func (location, target pvector.PVector) float64) Objective {
+    return func (location pvector.PVector, targets []pvector.PVector) (pvector.PVector, error) {
+        if (len(targets) == 0) {
+            return pvector.PVector{}, ErrNoTarget
+        }
+        var best pvector.PVector = targets[0]
+        var most float64 = reward(location, targets[0])
+        for _, target := range targets[1:] {
+            if r := reward(location, target); r > most {
+                best = target
+                most = r
+            }
+        }
+        return best, nil
+    }
+}
+
+/**
+ * A reward for Reward that prefers the closest target.
+ */
+func Closest (location, target pvector.PVector) float64 {
+    return -location.Dist(target)
+}
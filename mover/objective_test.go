@@ -0,0 +1,55 @@
+package mover
+
+import (
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+)
+
+func TestObjectives(t *testing.T) {
+    location := pvector.PVectorFactory(0, 0)
+    targets := []pvector.PVector{pvector.PVectorFactory(10, 10), pvector.PVectorFactory(-1, 2), pvector.PVectorFactory(5, 0)}
+
+    tests := []struct {
+        name string
+        objective Objective
+        want pvector.PVector
+    }{
+        {"Target(0)", Target(0), targets[0]},
+        {"Target(2)", Target(2), targets[2]},
+        {"Point", Point(pvector.PVectorFactory(209, 215)), pvector.PVectorFactory(209, 215)},
+        {"Reward(Closest)", Reward(Closest), targets[1]},
+        {"Reward(furthest)", Reward(func (l, t pvector.PVector) float64 { return l.Dist(t) }), targets[0]},
+        {"Reward tie", Reward(func (l, t pvector.PVector) float64 { return 0 }), targets[0]},
+        {"Reward all -Inf", Reward(func (l, t pvector.PVector) float64 { return math.Inf(-1) }), targets[0]},
+        {"Reward all NaN", Reward(func (l, t pvector.PVector) float64 { return math.NaN() }), targets[0]},
+        {"callback", func (l pvector.PVector, ts []pvector.PVector) (pvector.PVector, error) { return ts[0].Add(ts[1]), nil }, pvector.PVectorFactory(9, 12)},
+    }
+
+    for _, test := range tests {
+        if got, err := test.objective(location, targets); err != nil || got != test.want {
+            t.Errorf("%v == %v, %v, want %v", test.name, got, err, test.want)
+        }
+    }
+}
+
+func TestObjectivesNoTarget(t *testing.T) {
+    location := pvector.PVectorFactory(0, 0)
+    targets := []pvector.PVector{pvector.PVectorFactory(10, 10), pvector.PVectorFactory(-1, 2)}
+
+    tests := []struct {
+        name string
+        objective Objective
+        targets []pvector.PVector
+    }{
+        {"Target(2)", Target(2), targets},
+        {"Target(-1)", Target(-1), targets},
+        {"Reward(Closest)", Reward(Closest), nil},
+    }
+
+    for _, test := range tests {
+        if _, err := test.objective(location, test.targets); err != ErrNoTarget {
+            t.Errorf("%v of %v targets returned %v, want %v", test.name, len(test.targets), err, ErrNoTarget)
+        }
+    }
+}
@@ -0,0 +1,118 @@
+package mover
+
+import (
+    "fmt"
+    "github.com/josephdpurcell/go-neural-network/perceptronMover"
+)
+
+var (
+    ErrInvalidMaxSpeed = fmt.Errorf("mover: max speed must not be negative")
+    ErrInvalidMaxForce = fmt.Errorf("mover: max force must not be negative")
+    ErrInvalidMass = fmt.Errorf("mover: mass must be above 0")
+)
+
+/**
+ * Everything about a Mover that can be chosen when creating it.
+ */
+type options struct {
+    maxspeed float64
+    maxforce float64
+    mass float64
+    inputs int
+    learning float64
+    brain *perceptronMover.Perceptron
+    objective Objective
+}
+
+/**
+ * Changes how MoverFactory creates a Mover.
+ */
+type Option func (o *options)
+
+/**
+ * How fast the Mover may go. Defaults to 20.
+ */
+func WithMaxSpeed (maxspeed float64) Option {
+    return func (o *options) {
+        o.maxspeed = maxspeed
+    }
+}
+
+/**
+ * How hard the Mover may steer. Defaults to 2000.
+ */
+func WithMaxForce (maxforce float64) Option {
+    return func (o *options) {
+        o.maxforce = maxforce
+    }
+}
+
+/**
+ * How heavy the Mover is, i.e. how much forces are divided by. Defaults to
+ * 100.
+ */
+func WithMass (mass float64) Option {
+    return func (o *options) {
+        o.mass = mass
+    }
+}
+
+/**
+ * How many targets the Mover's brain weighs, which is how many it must be
+ * given to Seek. Defaults to 2.
+ */
+func WithInputs (n int) Option {
+    return func (o *options) {
+        o.inputs = n
+    }
+}
+
+/**
+ * How fast the Mover's brain learns. Defaults to 0.00001.
+ */
+func WithLearningRate (learning float64) Option {
+    return func (o *options) {
+        o.learning = learning
+    }
+}
+
+/**
+ * Use a copy of the given brain, e.g. one loaded from a file, instead of
+ * creating one. WithInputs and WithLearningRate are then ignored.
+ */
+func WithBrain (brain perceptronMover.Perceptron) Option {
+    return func (o *options) {
+        o.brain = &brain
+    }
+}
+
+/**
+ * What the Mover learns to head towards. Defaults to Target(0).
+ */
+func WithObjective (objective Objective) Option {
+    return func (o *options) {
+        o.objective = objective
+    }
+}
+
+/**
+ * Check the options make a Mover that can move.
+ */
+func (o options) check () error {
+    if (!(o.maxspeed >= 0)) {
+        return ErrInvalidMaxSpeed
+    }
+    if (!(o.maxforce >= 0)) {
+        return ErrInvalidMaxForce
+    }
+    if (!(o.mass > 0)) {
+        return ErrInvalidMass
+    }
+    if (o.objective == nil) {
+        return ErrNilObjective
+    }
+    if (o.brain != nil && o.brain.Inputs() == 0) {
+        return perceptronMover.ErrInvalidSize
+    }
+    return nil
+}
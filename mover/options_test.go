@@ -0,0 +1,101 @@
+package mover
+
+import (
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/perceptronMover"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+)
+
+var zero = pvector.PVectorFactory(0, 0)
+
+func TestMoverFactoryDefaults(t *testing.T) {
+    m, err := MoverFactory(zero, zero, zero)
+    if err != nil {
+        t.Fatalf("MoverFactory() returned %v", err)
+    }
+    if m.maxspeed != 20 || m.maxforce != 2000 || m.mass != 100 {
+        t.Errorf("Defaults are maxspeed %v, maxforce %v, mass %v, want 20, 2000, 100", m.maxspeed, m.maxforce, m.mass)
+    }
+    if err := m.Seek([]pvector.PVector{zero, zero}); err != nil {
+        t.Errorf("The default brain should take 2 targets, but m.Seek() returned %v", err)
+    }
+}
+
+func TestMoverFactoryOptions(t *testing.T) {
+    m, err := MoverFactory(zero, zero, zero,
+        WithMaxSpeed(5),
+        WithMaxForce(50),
+        WithMass(2),
+        WithInputs(3),
+        WithLearningRate(0.5),
+        WithObjective(Target(2)),
+    )
+    if err != nil {
+        t.Fatalf("MoverFactory() returned %v", err)
+    }
+    if m.maxspeed != 5 || m.maxforce != 50 || m.mass != 2 {
+        t.Errorf("Options gave maxspeed %v, maxforce %v, mass %v, want 5, 50, 2", m.maxspeed, m.maxforce, m.mass)
+    }
+    targets := []pvector.PVector{pvector.PVectorFactory(1, 0), pvector.PVectorFactory(0, 1), pvector.PVectorFactory(3, 4)}
+    if got, _ := m.objective(zero, targets); got != targets[2] {
+        t.Errorf("WithObjective(Target(2)) picked %v, want %v", got, targets[2])
+    }
+    if err := m.Seek(targets); err != nil {
+        t.Errorf("WithInputs(3) should take 3 targets, but m.Seek() returned %v", err)
+    }
+
+    // From the origin, each steering force is its target: half the way there
+    // times a mass of 2. The brain learned at 0.5 from weights of (1, 1) with
+    // an error of (3, 4), so each weight grew by force * (3, 4) * 0.5.
+    ones := []pvector.PVector{pvector.PVectorFactory(1, 1), pvector.PVectorFactory(1, 1), pvector.PVectorFactory(1, 1)}
+    weights, _ := m.brain.Weigh(ones)
+    want := []pvector.PVector{pvector.PVectorFactory(2.5, 1), pvector.PVectorFactory(1, 3), pvector.PVectorFactory(5.5, 9)}
+    for i := 0; i < len(want); i++ {
+        if weights[i].Dist(want[i]) > 1e-12 {
+            t.Errorf("After one Seek at 0.5 the weights are %v, want %v", weights, want)
+            break
+        }
+    }
+}
+
+func TestMoverFactoryBrain(t *testing.T) {
+    brain, _ := perceptronMover.PerceptronFactory(1, 0.1)
+    m, err := MoverFactory(zero, zero, zero, WithBrain(brain), WithInputs(5))
+    if err != nil {
+        t.Fatalf("MoverFactory() returned %v", err)
+    }
+    if err := m.Seek([]pvector.PVector{pvector.PVectorFactory(1, 1)}); err != nil {
+        t.Errorf("WithBrain should win over WithInputs, but m.Seek() returned %v", err)
+    }
+
+    // The Mover learns with its own copy of the brain.
+    ones := []pvector.PVector{pvector.PVectorFactory(1, 1)}
+    if weights, _ := brain.Weigh(ones); weights[0] != pvector.PVectorFactory(1, 1) {
+        t.Errorf("Seeking changed the brain given to WithBrain, its weights are now %v", weights)
+    }
+}
+
+func TestMoverFactoryInvalid(t *testing.T) {
+    tests := []struct {
+        name string
+        option Option
+        want error
+    }{
+        {"WithMaxSpeed(-1)", WithMaxSpeed(-1), ErrInvalidMaxSpeed},
+        {"WithMaxSpeed(NaN)", WithMaxSpeed(math.NaN()), ErrInvalidMaxSpeed},
+        {"WithMaxForce(-1)", WithMaxForce(-1), ErrInvalidMaxForce},
+        {"WithMass(0)", WithMass(0), ErrInvalidMass},
+        {"WithMass(-5)", WithMass(-5), ErrInvalidMass},
+        {"WithInputs(0)", WithInputs(0), perceptronMover.ErrInvalidSize},
+        {"WithLearningRate(-1)", WithLearningRate(-1), perceptronMover.ErrInvalidLearningRate},
+        {"WithObjective(nil)", WithObjective(nil), ErrNilObjective},
+        {"WithBrain(Perceptron{})", WithBrain(perceptronMover.Perceptron{}), perceptronMover.ErrInvalidSize},
+    }
+
+    for _, test := range tests {
+        if _, err := MoverFactory(zero, zero, zero, test.option); err != test.want {
+            t.Errorf("MoverFactory(%v) error == %v, want %v", test.name, err, test.want)
+        }
+    }
+}
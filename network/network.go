@@ -0,0 +1,279 @@
+package network
+
+import (
+    "fmt"
+    "github.com/josephdpurcell/go-neural-network/activation"
+    "github.com/josephdpurcell/go-neural-network/event"
+    "github.com/josephdpurcell/go-neural-network/initializer"
+    "github.com/josephdpurcell/go-neural-network/loss"
+    "github.com/josephdpurcell/go-neural-network/mat"
+    "github.com/josephdpurcell/go-neural-network/optimizer"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+var (
+    ErrInvalidSize = fmt.Errorf("network: needs at least two sizes, all above 0")
+    ErrInvalidLearningRate = fmt.Errorf("network: learning rate must not be negative")
+    ErrInvalidLayer = fmt.Errorf("network: no such layer")
+    ErrNilInitializer = fmt.Errorf("network: initializers must not be nil")
+)
+
+/**
+ * A fully connected layer of neurons.
+ *
+ * weights.At(j, i) is the weight from input i to neuron j. The optimizer keeps
+ * track of each row of weights as group+j and of the biases as group+rows.
+ */
+type layer struct {
+    weights mat.Matrix
+    biases []float64
+    activation activation.Activation
+    group int
+}
+
+/**
+ * A multi-layer feedforward neural network.
+ *
+ * The first size given to the factory is the number of inputs, the last is
+ * the number of outputs and anything in between is a hidden layer.
+ */
+type Network struct {
+    layers []layer
+    optimizer optimizer.Optimizer
+    loss loss.Loss
+    observer event.Observer
+}
+
+/**
+ * Feedforward means: here are the inputs for the Network, pass them through
+ * every layer and tell us the outputs.
+ *
+ * There must be exactly one input per input neuron, or a *mat.DimensionError
+ * is returned.
+ */
+func (n Network) Feedforward (input []float64) ([]float64, error) {
+    if err := n.checkInput(input); err != nil {
+        return nil, err
+    }
+    output := input
+    for l := 0; l < len(n.layers); l++ {
+        _, output = n.layers[l].feedforward(output)
+    }
+    return output, nil
+}
+
+/**
+ * Feedforward many inputs at once.
+ */
+func (n Network) FeedforwardBatch (inputs [][]float64) ([][]float64, error) {
+    outputs := make([][]float64, len(inputs))
+    for i := 0; i < len(inputs); i++ {
+        output, err := n.Feedforward(inputs[i])
+        if err != nil {
+            return nil, fmt.Errorf("input %v: %w", i, err)
+        }
+        outputs[i] = output
+    }
+    return outputs, nil
+}
+
+/**
+ * Adjust every weight and bias using backpropagation.
+ *
+ * The error is measured with the Network's loss, and that loss is returned,
+ * and sent to the observer if any, as it was before the weights were
+ * adjusted.
+ *
+ * Nothing changes when the input or desired outputs are the wrong length.
+ */
+func (n *Network) Train (input, desired []float64) (float64, error) {
+    if err := n.checkInput(input); err != nil {
+        return 0, err
+    }
+    neurons, _ := n.layers[len(n.layers) - 1].weights.Dims()
+    if err := mat.CheckLength("network: desired", neurons, len(desired)); err != nil {
+        return 0, err
+    }
+
+    // Remember every layer's weighted sums and outputs on the way forward.
+    sums := make([][]float64, len(n.layers))
+    outputs := make([][]float64, len(n.layers) + 1)
+    outputs[0] = input
+    for l := 0; l < len(n.layers); l++ {
+        sums[l], outputs[l + 1] = n.layers[l].feedforward(outputs[l])
+    }
+
+    // The error at the output layer.
+    last := len(n.layers) - 1
+    output := outputs[last + 1]
+    value := n.loss.Value(output, desired)
+    gradient := n.loss.Gradient(output, desired)
+    delta := activation.BackwardAll(n.layers[last].activation, sums[last], gradient)
+
+    // Walk backwards, pushing the error into each previous layer before the
+    // current layer's weights are changed.
+    for l := last; l >= 0; l-- {
+        current := n.layers[l]
+        var previous []float64
+        if (l > 0) {
+            gradient = current.weights.T().MulVec(delta)
+            previous = activation.BackwardAll(n.layers[l - 1].activation, sums[l - 1], gradient)
+        }
+
+        gradients := mat.Outer(delta, outputs[l])
+        for j := 0; j < len(delta); j++ {
+            n.optimizer.Update(current.group + j, current.weights.Row(j), gradients.Row(j))
+        }
+        n.optimizer.Update(current.group + len(delta), current.biases, delta)
+
+        delta = previous
+    }
+
+    event.Notify(n.observer, event.Step{
+        Input: input,
+        Desired: desired,
+        Output: output,
+        Loss: value,
+    })
+
+    return value, nil
+}
+
+/**
+ * Check the input has one value per input neuron.
+ */
+func (n Network) checkInput (input []float64) error {
+    _, inputs := n.layers[0].weights.Dims()
+    return mat.CheckLength("network: input", inputs, len(input))
+}
+
+/**
+ * Change the activation used by a layer.
+ *
+ * Layer 0 is the first hidden layer, the last layer is the output layer.
+ * ErrInvalidLayer is returned, and nothing changes, for any other l.
+ */
+func (n *Network) SetActivation (l int, a activation.Activation) error {
+    if (l < 0 || l >= len(n.layers)) {
+        return ErrInvalidLayer
+    }
+    n.layers[l].activation = a
+    return nil
+}
+
+/**
+ * Change how the Network measures its error.
+ */
+func (n *Network) SetLoss (l loss.Loss) {
+    n.loss = l
+}
+
+/**
+ * The learning rate the Network's optimizer currently uses.
+ */
+func (n Network) LearningRate () float64 {
+    return n.optimizer.LearningRate()
+}
+
+/**
+ * Change the learning rate of the Network's optimizer, e.g. from a schedule.
+ */
+func (n *Network) SetLearningRate (rate float64) {
+    n.optimizer.SetLearningRate(rate)
+}
+
+/**
+ * Change how the Network updates its weights.
+ */
+func (n *Network) SetOptimizer (o optimizer.Optimizer) {
+    n.optimizer = o
+}
+
+/**
+ * Report each training step to the observer, or to nobody when nil.
+ */
+func (n *Network) SetObserver (o event.Observer) {
+    n.observer = o
+}
+
+/**
+ * Compute the weighted sums and the activated outputs of a layer.
+ */
+func (l layer) feedforward (input []float64) ([]float64, []float64) {
+    sums := mat.AddVec(l.weights.MulVec(input), l.biases)
+    return sums, activation.ForwardAll(l.activation, sums)
+}
+
+/**
+ * Create a Network.
+ *
+ * sizes = the number of neurons in each layer, starting with the inputs
+ * learning = the speed at which learning will happen
+ * src = where the random starting weights come from
+ *
+ * Weights and biases start at random between -1 and 1, every layer uses the
+ * sigmoid activation, weights are updated with plain SGD at the learning rate
+ * and the error is measured with mean squared error.
+ *
+ * ErrInvalidSize is returned when there are fewer than two sizes or any size
+ * is not above 0, and ErrInvalidLearningRate when learning is negative.
+ */
+func NetworkFactory (sizes []int, learning float64, src *random.Source) (Network, error) {
+    uniform := initializer.UniformFactory(-1, 1, src)
+    return InitializedNetworkFactory(sizes, learning, uniform, uniform)
+}
+
+/**
+ * Create a Network whose starting weights and biases are picked by the given
+ * initializers, e.g. He for the weights of ReLU layers and zeros for the
+ * biases. ErrNilInitializer is returned when either is nil.
+ */
+func InitializedNetworkFactory (sizes []int, learning float64, w, b initializer.Initializer) (Network, error) {
+    if err := check(sizes, learning); err != nil {
+        return Network{}, err
+    }
+    if (w == nil || b == nil) {
+        return Network{}, ErrNilInitializer
+    }
+    layers := make([]layer, len(sizes) - 1)
+    var group int = 0
+    for l := 0; l < len(layers); l++ {
+        weights := mat.MatrixFactory(sizes[l + 1], sizes[l], nil)
+        biases := make([]float64, sizes[l + 1])
+        for j := 0; j < sizes[l + 1]; j++ {
+            w.Initialize(weights.Row(j), sizes[l], sizes[l + 1])
+        }
+        b.Initialize(biases, sizes[l], sizes[l + 1])
+        layers[l] = layer{
+            weights: weights,
+            biases: biases,
+            activation: activation.Sigmoid{},
+            group: group,
+        }
+        group = group + sizes[l + 1] + 1
+    }
+    n := Network{
+        layers: layers,
+        optimizer: optimizer.SGDFactory(learning),
+        loss: loss.MSE{},
+    }
+    return n, nil
+}
+
+/**
+ * Check the sizes and learning rate a Network is created with.
+ */
+func check (sizes []int, learning float64) error {
+    if (len(sizes) < 2) {
+        return ErrInvalidSize
+    }
+    for _, size := range sizes {
+        if (size <= 0) {
+            return ErrInvalidSize
+        }
+    }
+    if (!(learning >= 0)) {
+        return ErrInvalidLearningRate
+    }
+    return nil
+}
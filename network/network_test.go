@@ -0,0 +1,223 @@
+package network
+
+import (
+    "errors"
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/activation"
+    "github.com/josephdpurcell/go-neural-network/initializer"
+    "github.com/josephdpurcell/go-neural-network/mat"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+/**
+ * Give every weight and bias a fixed, varied starting value so training is
+ * repeatable.
+ */
+func fixWeights(n *Network) {
+    var k float64 = 0
+    for l := 0; l < len(n.layers); l++ {
+        rows, cols := n.layers[l].weights.Dims()
+        for j := 0; j < rows; j++ {
+            for i := 0; i < cols; i++ {
+                k++
+                n.layers[l].weights.Set(j, i, math.Sin(k * 7.3))
+            }
+            k++
+            n.layers[l].biases[j] = math.Sin(k * 7.3)
+        }
+    }
+}
+
+func TestNetworkFactory(t *testing.T) {
+    n, _ := NetworkFactory([]int{2, 3, 1}, 0.5, nil)
+
+    if len(n.layers) != 2 {
+        t.Fatalf("NetworkFactory({2, 3, 1}) has %v layers, want %v", len(n.layers), 2)
+    }
+
+    if rows, cols := n.layers[0].weights.Dims(); rows != 3 || cols != 2 {
+        t.Errorf("Hidden layer should be 3x2, but is %vx%v", rows, cols)
+    }
+
+    if rows, cols := n.layers[1].weights.Dims(); rows != 1 || cols != 3 {
+        t.Errorf("Output layer should be 1x3, but is %vx%v", rows, cols)
+    }
+
+    if n.optimizer.LearningRate() != 0.5 {
+        t.Errorf("NetworkFactory({2, 3, 1}, %v) learning == %v, want %v", 0.5, n.optimizer.LearningRate(), 0.5)
+    }
+}
+
+func TestNetworkFactorySeeded(t *testing.T) {
+    a, _ := NetworkFactory([]int{2, 3, 1}, 0.5, random.SourceFactory(7))
+    b, _ := NetworkFactory([]int{2, 3, 1}, 0.5, random.SourceFactory(7))
+
+    input := []float64{0.5, -0.5}
+    x, _ := a.Feedforward(input)
+    y, _ := b.Feedforward(input)
+    if x[0] != y[0] {
+        t.Errorf("Networks created from the same seed should give the same output")
+    }
+}
+
+func TestInitializedNetworkFactory(t *testing.T) {
+    n, _ := InitializedNetworkFactory([]int{2, 3, 1}, 0.5, initializer.ConstantFactory(0.5), initializer.ZerosFactory())
+
+    for l := 0; l < len(n.layers); l++ {
+        rows, cols := n.layers[l].weights.Dims()
+        for j := 0; j < rows; j++ {
+            for i := 0; i < cols; i++ {
+                if n.layers[l].weights.At(j, i) != 0.5 {
+                    t.Fatalf("Layer %v weights should start at 0.5, but are %v", l, n.layers[l].weights.Rows())
+                }
+            }
+            if n.layers[l].biases[j] != 0 {
+                t.Fatalf("Layer %v biases should start at 0, but are %v", l, n.layers[l].biases)
+            }
+        }
+    }
+}
+
+func TestNetworkFeedforward(t *testing.T) {
+    n, _ := NetworkFactory([]int{2, 1}, 0.5, nil)
+    copy(n.layers[0].weights.Row(0), []float64{1, 1})
+    n.layers[0].biases[0] = -1
+
+    input := []float64{0.5, 0.5}
+    got, _ := n.Feedforward(input)
+    if len(got) != 1 || got[0] != 0.5 {
+        t.Errorf("n.Feedforward(%v) == %v, want %v", input, got, []float64{0.5})
+    }
+}
+
+func TestNetworkTrainGradient(t *testing.T) {
+    outputs := []activation.Activation{activation.Sigmoid{}, activation.Softmax{}}
+
+    for _, output := range outputs {
+        input := []float64{0.3, -0.7}
+        desired := []float64{1, 0}
+        const h float64 = 1e-6
+
+        create := func () Network {
+            m, _ := NetworkFactory([]int{2, 3, 2}, 1, nil)
+            fixWeights(&m)
+            m.SetActivation(0, activation.Tanh{})
+            m.SetActivation(1, output)
+            return m
+        }
+        loss := func (m Network) float64 {
+            output, _ := m.Feedforward(input)
+            return m.loss.Value(output, desired)
+        }
+
+        // With a learning rate of 1 the change in each weight is the negative
+        // of its gradient, which we can compare against a numeric estimate.
+        n := create()
+        before := create()
+        n.Train(input, desired)
+
+        for l := 0; l < len(n.layers); l++ {
+            rows, cols := n.layers[l].weights.Dims()
+            for j := 0; j < rows; j++ {
+                for i := 0; i < cols; i++ {
+                    w := before.layers[l].weights.At(j, i)
+                    before.layers[l].weights.Set(j, i, w + h)
+                    up := loss(before)
+                    before.layers[l].weights.Set(j, i, w - h)
+                    down := loss(before)
+                    before.layers[l].weights.Set(j, i, w)
+
+                    want := -(up - down) / (2 * h)
+                    got := n.layers[l].weights.At(j, i) - w
+                    if math.Abs(got - want) > 1e-6 {
+                        t.Errorf("%T: weight [%v][%v][%v] changed by %v, want %v", output, l, j, i, got, want)
+                    }
+                }
+            }
+        }
+    }
+}
+
+func TestNetworkTrainXOR(t *testing.T) {
+    n, _ := NetworkFactory([]int{2, 3, 1}, 0.5, nil)
+    fixWeights(&n)
+
+    inputs := [][]float64{{0, 0}, {0, 1}, {1, 0}, {1, 1}}
+    answers := [][]float64{{0}, {1}, {1}, {0}}
+
+    for epoch := 0; epoch < 10000; epoch++ {
+        for i := 0; i < len(inputs); i++ {
+            n.Train(inputs[i], answers[i])
+        }
+    }
+
+    got, _ := n.FeedforwardBatch(inputs)
+    for i := 0; i < len(inputs); i++ {
+        if math.Abs(got[i][0] - answers[i][0]) > 0.1 {
+            t.Errorf("n.Feedforward(%v) == %v, want %v", inputs[i], got[i], answers[i])
+        }
+    }
+}
+
+func TestNetworkFactoryInvalid(t *testing.T) {
+    tests := []struct {
+        sizes []int
+        learning float64
+        want error
+    }{
+        {[]int{2}, 0.5, ErrInvalidSize},
+        {nil, 0.5, ErrInvalidSize},
+        {[]int{2, 0, 1}, 0.5, ErrInvalidSize},
+        {[]int{2, -3}, 0.5, ErrInvalidSize},
+        {[]int{2, 1}, -0.5, ErrInvalidLearningRate},
+    }
+
+    for _, test := range tests {
+        if _, err := NetworkFactory(test.sizes, test.learning, nil); err != test.want {
+            t.Errorf("NetworkFactory(%v, %v) error == %v, want %v", test.sizes, test.learning, err, test.want)
+        }
+    }
+
+    if _, err := InitializedNetworkFactory([]int{2, 1}, 0.5, nil, initializer.ZerosFactory()); err != ErrNilInitializer {
+        t.Errorf("InitializedNetworkFactory() with a nil initializer error == %v, want %v", err, ErrNilInitializer)
+    }
+}
+
+func TestNetworkSetActivationInvalid(t *testing.T) {
+    n, _ := NetworkFactory([]int{2, 3, 1}, 0.5, nil)
+
+    for _, l := range []int{-1, 2} {
+        if err := n.SetActivation(l, activation.Tanh{}); err != ErrInvalidLayer {
+            t.Errorf("n.SetActivation(%v) error == %v, want %v", l, err, ErrInvalidLayer)
+        }
+    }
+    if err := n.SetActivation(1, activation.Tanh{}); err != nil {
+        t.Errorf("n.SetActivation(1) returned %v", err)
+    }
+}
+
+func TestNetworkWrongLength(t *testing.T) {
+    n, _ := NetworkFactory([]int{2, 3, 1}, 0.5, nil)
+    fixWeights(&n)
+    var dimension *mat.DimensionError
+
+    if _, err := n.Feedforward([]float64{1}); !errors.As(err, &dimension) {
+        t.Errorf("n.Feedforward({1}) error == %v, want a *mat.DimensionError", err)
+    }
+    if _, err := n.FeedforwardBatch([][]float64{{1, 2}, {1, 2, 3}}); !errors.As(err, &dimension) {
+        t.Errorf("n.FeedforwardBatch() error == %v, want a *mat.DimensionError", err)
+    }
+
+    before, _ := n.Feedforward([]float64{1, 1})
+    if _, err := n.Train([]float64{1, 1, 1}, []float64{1}); !errors.As(err, &dimension) {
+        t.Errorf("n.Train() with 3 inputs error == %v, want a *mat.DimensionError", err)
+    }
+    if _, err := n.Train([]float64{1, 1}, []float64{1, 0}); !errors.As(err, &dimension) {
+        t.Errorf("n.Train() with 2 desired outputs error == %v, want a *mat.DimensionError", err)
+    }
+    after, _ := n.Feedforward([]float64{1, 1})
+    if before[0] != after[0] {
+        t.Errorf("Failed training changed the output from %v to %v", before, after)
+    }
+}
@@ -0,0 +1,159 @@
+package network
+
+import (
+    "encoding/gob"
+    "encoding/json"
+    "fmt"
+    "io"
+    "github.com/josephdpurcell/go-neural-network/activation"
+    "github.com/josephdpurcell/go-neural-network/loss"
+    "github.com/josephdpurcell/go-neural-network/mat"
+    "github.com/josephdpurcell/go-neural-network/optimizer"
+)
+
+/**
+ * The version of the saved format. Bump it whenever the saved fields change
+ * in a way older code cannot read.
+ */
+const Version int = 1
+
+/**
+ * What gets saved of a layer.
+ */
+type savedLayer struct {
+    Weights [][]float64 `json:"weights"`
+    Biases []float64 `json:"biases"`
+    Activation activation.Spec `json:"activation"`
+}
+
+/**
+ * What gets saved of a Network.
+ *
+ * Sizes describes the architecture the same way NetworkFactory takes it. Only
+ * the learning rate of the optimizer is kept, so a loaded Network continues
+ * with plain SGD and mean squared error.
+ */
+type saved struct {
+    Version int `json:"version"`
+    Sizes []int `json:"sizes"`
+    Layers []savedLayer `json:"layers"`
+    Learning float64 `json:"learning"`
+}
+
+/**
+ * Capture what needs saving.
+ */
+func (n Network) save () (saved, error) {
+    s := saved{
+        Version: Version,
+        Sizes: make([]int, len(n.layers) + 1),
+        Layers: make([]savedLayer, len(n.layers)),
+        Learning: n.optimizer.LearningRate(),
+    }
+    for l := 0; l < len(n.layers); l++ {
+        spec, err := activation.Describe(n.layers[l].activation)
+        if err != nil {
+            return saved{}, err
+        }
+        s.Sizes[l + 1], s.Sizes[l] = n.layers[l].weights.Dims()
+        s.Layers[l] = savedLayer{
+            Weights: n.layers[l].weights.Rows(),
+            Biases: n.layers[l].biases,
+            Activation: spec,
+        }
+    }
+    return s, nil
+}
+
+/**
+ * Turn what was saved back into a Network, checking that the weights fit the
+ * architecture.
+ */
+func (s saved) load () (Network, error) {
+    if (s.Version != Version) {
+        return Network{}, fmt.Errorf("network: cannot load version %v, want %v", s.Version, Version)
+    }
+    if err := check(s.Sizes, s.Learning); err != nil {
+        return Network{}, err
+    }
+    if (len(s.Layers) != len(s.Sizes) - 1) {
+        return Network{}, fmt.Errorf("network: %v sizes need %v layers, got %v", len(s.Sizes), len(s.Sizes) - 1, len(s.Layers))
+    }
+
+    layers := make([]layer, len(s.Layers))
+    var group int = 0
+    for l := 0; l < len(layers); l++ {
+        a, err := activation.FromSpec(s.Layers[l].Activation)
+        if err != nil {
+            return Network{}, err
+        }
+        weights := s.Layers[l].Weights
+        biases := s.Layers[l].Biases
+        if (len(weights) != s.Sizes[l + 1] || len(biases) != s.Sizes[l + 1]) {
+            return Network{}, fmt.Errorf("network: layer %v should have %v neurons", l, s.Sizes[l + 1])
+        }
+        for j := 0; j < len(weights); j++ {
+            if (len(weights[j]) != s.Sizes[l]) {
+                return Network{}, fmt.Errorf("network: layer %v should have %v inputs", l, s.Sizes[l])
+            }
+        }
+        layers[l] = layer{
+            weights: mat.RowsFactory(weights),
+            biases: biases,
+            activation: a,
+            group: group,
+        }
+        group = group + len(weights) + 1
+    }
+
+    n := Network{
+        layers: layers,
+        optimizer: optimizer.SGDFactory(s.Learning),
+        loss: loss.MSE{},
+    }
+    return n, nil
+}
+
+/**
+ * Save the Network as JSON.
+ */
+func (n Network) Save (w io.Writer) error {
+    s, err := n.save()
+    if err != nil {
+        return err
+    }
+    return json.NewEncoder(w).Encode(s)
+}
+
+/**
+ * Load a Network saved as JSON.
+ */
+func Load (r io.Reader) (Network, error) {
+    var s saved
+    if err := json.NewDecoder(r).Decode(&s); err != nil {
+        return Network{}, err
+    }
+    return s.load()
+}
+
+/**
+ * Save the Network in a compact binary form.
+ */
+func (n Network) SaveBinary (w io.Writer) error {
+    s, err := n.save()
+    if err != nil {
+        return err
+    }
+    return gob.NewEncoder(w).Encode(s)
+}
+
+/**
+ * Load a Network saved with SaveBinary.
+ */
+func LoadBinary (r io.Reader) (Network, error) {
+    var s saved
+    if err := gob.NewDecoder(r).Decode(&s); err != nil {
+        return Network{}, err
+    }
+    return s.load()
+}
@@ -0,0 +1,89 @@
+package network
+
+import (
+    "bytes"
+    "strings"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/activation"
+)
+
+func TestSaveLoad(t *testing.T) {
+    n, _ := NetworkFactory([]int{2, 3, 2}, 0.5, nil)
+    fixWeights(&n)
+    n.SetActivation(0, activation.LeakyReLU{Alpha: 0.1})
+    n.SetActivation(1, activation.Softmax{})
+
+    var b bytes.Buffer
+    if err := n.Save(&b); err != nil {
+        t.Fatalf("n.Save() returned %v", err)
+    }
+    if !strings.Contains(b.String(), `"version":1`) || !strings.Contains(b.String(), `"sizes":[2,3,2]`) {
+        t.Errorf("n.Save() should write the version and sizes, but wrote %v", b.String())
+    }
+
+    got, err := Load(&b)
+    if err != nil {
+        t.Fatalf("Load() returned %v", err)
+    }
+    assertSameNetwork(t, got, n)
+}
+
+func TestSaveLoadBinary(t *testing.T) {
+    n, _ := NetworkFactory([]int{3, 4, 4, 1}, 0.1, nil)
+
+    var b bytes.Buffer
+    if err := n.SaveBinary(&b); err != nil {
+        t.Fatalf("n.SaveBinary() returned %v", err)
+    }
+
+    got, err := LoadBinary(&b)
+    if err != nil {
+        t.Fatalf("LoadBinary() returned %v", err)
+    }
+    assertSameNetwork(t, got, n)
+}
+
+func TestLoadInvalid(t *testing.T) {
+    tests := []string{
+        `{"version": 99, "sizes": [1, 1], "layers": [{"weights": [[1]], "biases": [0], "activation": {"name": "sigmoid"}}]}`,
+        `{"version": 1, "sizes": [1, 1, 1], "layers": [{"weights": [[1]], "biases": [0], "activation": {"name": "sigmoid"}}]}`,
+        `{"version": 1, "sizes": [2, 1], "layers": [{"weights": [[1]], "biases": [0], "activation": {"name": "sigmoid"}}]}`,
+        `{"version": 1, "sizes": [1, 1], "layers": [{"weights": [[1]], "biases": [0], "activation": {"name": "nope"}}]}`,
+        `{"version": 1, "sizes": [1, 1], "layers": [{"weights": [[1]], "biases": [0], "activation": {"name": "sigmoid"}}], "learning": -1}`,
+        `{"version": 1, "sizes": [0, 1], "layers": [{"weights": [], "biases": [0], "activation": {"name": "sigmoid"}}]}`,
+    }
+
+    for _, test := range tests {
+        if _, err := Load(strings.NewReader(test)); err == nil {
+            t.Errorf("Load(%v) should have failed", test)
+        }
+    }
+}
+
+func assertSameNetwork(t *testing.T, got, want Network) {
+    if got.LearningRate() != want.LearningRate() {
+        t.Errorf("Loaded learning rate %v, want %v", got.LearningRate(), want.LearningRate())
+    }
+    if len(got.layers) != len(want.layers) {
+        t.Fatalf("Loaded %v layers, want %v", len(got.layers), len(want.layers))
+    }
+    for l := 0; l < len(want.layers); l++ {
+        if got.layers[l].activation != want.layers[l].activation {
+            t.Errorf("Layer %v activation is %v, want %v", l, got.layers[l].activation, want.layers[l].activation)
+        }
+        if got.layers[l].group != want.layers[l].group {
+            t.Errorf("Layer %v group is %v, want %v", l, got.layers[l].group, want.layers[l].group)
+        }
+    }
+
+    _, inputs := want.layers[0].weights.Dims()
+    input := []float64{0.25, -0.5, 1}[:inputs]
+    a, _ := got.Feedforward(input)
+    b, _ := want.Feedforward(input)
+    for j := 0; j < len(b); j++ {
+        if a[j] != b[j] {
+            t.Errorf("Loaded network gives %v for %v, want %v", a, input, b)
+            break
+        }
+    }
+}
@@ -0,0 +1,242 @@
+package optimizer
+
+import (
+    "math"
+)
+
+/**
+ * An optimizer decides how parameters change given their gradients.
+ *
+ * A model may have several groups of parameters, e.g. one per layer, and
+ * passes a group number to Update so that the optimizer can keep state, like
+ * momentum, for each parameter in each group.
+ */
+type Optimizer interface {
+    Update (group int, params, gradients []float64)
+    LearningRate () float64
+    SetLearningRate (rate float64)
+}
+
+/**
+ * Small number added to denominators so we never divide by 0.
+ */
+const epsilon float64 = 1e-8
+
+/**
+ * Keeps one value per parameter, per group.
+ */
+type state map[int][]float64
+
+/**
+ * Get the values for a group, creating them at 0 when first seen.
+ */
+func (s state) get (group int, n int) []float64 {
+    values, ok := s[group]
+    if (!ok || len(values) != n) {
+        values = make([]float64, n)
+        s[group] = values
+    }
+    return values
+}
+
+/**
+ * Plain stochastic gradient descent: step against the gradient.
+ */
+type SGD struct {
+    rate float64
+}
+
+func (o *SGD) Update (group int, params, gradients []float64) {
+    for i := 0; i < len(params); i++ {
+        params[i] = params[i] - (o.rate * gradients[i])
+    }
+}
+
+func (o *SGD) LearningRate () float64 {
+    return o.rate
+}
+
+func (o *SGD) SetLearningRate (rate float64) {
+    o.rate = rate
+}
+
+/**
+ * Create a plain SGD optimizer.
+ */
+func SGDFactory (rate float64) *SGD {
+    return &SGD{rate: rate}
+}
+
+/**
+ * Gradient descent with momentum, which keeps moving in the direction it has
+ * been moving so it rolls through small bumps.
+ *
+ * When nesterov is set, the gradient is applied as if it had been measured
+ * after the momentum step, which tends to overshoot less.
+ */
+type Momentum struct {
+    rate float64
+    momentum float64
+    nesterov bool
+    velocity state
+}
+
+func (o *Momentum) Update (group int, params, gradients []float64) {
+    velocity := o.velocity.get(group, len(params))
+    for i := 0; i < len(params); i++ {
+        velocity[i] = (o.momentum * velocity[i]) - (o.rate * gradients[i])
+        if (o.nesterov) {
+            params[i] = params[i] + (o.momentum * velocity[i]) - (o.rate * gradients[i])
+        } else {
+            params[i] = params[i] + velocity[i]
+        }
+    }
+}
+
+func (o *Momentum) LearningRate () float64 {
+    return o.rate
+}
+
+func (o *Momentum) SetLearningRate (rate float64) {
+    o.rate = rate
+}
+
+/**
+ * Create an SGD optimizer with momentum, usually around 0.9.
+ */
+func MomentumFactory (rate, momentum float64) *Momentum {
+    return &Momentum{
+        rate: rate,
+        momentum: momentum,
+        velocity: state{},
+    }
+}
+
+/**
+ * Create an SGD optimizer with Nesterov momentum.
+ */
+func NesterovFactory (rate, momentum float64) *Momentum {
+    o := MomentumFactory(rate, momentum)
+    o.nesterov = true
+    return o
+}
+
+/**
+ * AdaGrad gives each parameter its own learning rate, shrinking it as that
+ * parameter's squared gradients add up.
+ */
+type AdaGrad struct {
+    rate float64
+    squares state
+}
+
+func (o *AdaGrad) Update (group int, params, gradients []float64) {
+    squares := o.squares.get(group, len(params))
+    for i := 0; i < len(params); i++ {
+        squares[i] = squares[i] + (gradients[i] * gradients[i])
+        params[i] = params[i] - (o.rate * gradients[i] / (math.Sqrt(squares[i]) + epsilon))
+    }
+}
+
+func (o *AdaGrad) LearningRate () float64 {
+    return o.rate
+}
+
+func (o *AdaGrad) SetLearningRate (rate float64) {
+    o.rate = rate
+}
+
+/**
+ * Create an AdaGrad optimizer.
+ */
+func AdaGradFactory (rate float64) *AdaGrad {
+    return &AdaGrad{
+        rate: rate,
+        squares: state{},
+    }
+}
+
+/**
+ * RMSProp is like AdaGrad, but squared gradients decay over time so the
+ * learning rate does not shrink forever.
+ */
+type RMSProp struct {
+    rate float64
+    decay float64
+    squares state
+}
+
+func (o *RMSProp) Update (group int, params, gradients []float64) {
+    squares := o.squares.get(group, len(params))
+    for i := 0; i < len(params); i++ {
+        squares[i] = (o.decay * squares[i]) + ((1 - o.decay) * gradients[i] * gradients[i])
+        params[i] = params[i] - (o.rate * gradients[i] / (math.Sqrt(squares[i]) + epsilon))
+    }
+}
+
+func (o *RMSProp) LearningRate () float64 {
+    return o.rate
+}
+
+func (o *RMSProp) SetLearningRate (rate float64) {
+    o.rate = rate
+}
+
+/**
+ * Create an RMSProp optimizer, decay is usually around 0.9.
+ */
+func RMSPropFactory (rate, decay float64) *RMSProp {
+    return &RMSProp{
+        rate: rate,
+        decay: decay,
+        squares: state{},
+    }
+}
+
+/**
+ * Adam combines momentum with RMSProp, correcting both for starting at 0.
+ */
+type Adam struct {
+    rate float64
+    beta1 float64
+    beta2 float64
+    means state
+    squares state
+    steps map[int]int
+}
+
+func (o *Adam) Update (group int, params, gradients []float64) {
+    means := o.means.get(group, len(params))
+    squares := o.squares.get(group, len(params))
+    o.steps[group]++
+    t := float64(o.steps[group])
+    for i := 0; i < len(params); i++ {
+        means[i] = (o.beta1 * means[i]) + ((1 - o.beta1) * gradients[i])
+        squares[i] = (o.beta2 * squares[i]) + ((1 - o.beta2) * gradients[i] * gradients[i])
+        mean := means[i] / (1 - math.Pow(o.beta1, t))
+        square := squares[i] / (1 - math.Pow(o.beta2, t))
+        params[i] = params[i] - (o.rate * mean / (math.Sqrt(square) + epsilon))
+    }
+}
+
+func (o *Adam) LearningRate () float64 {
+    return o.rate
+}
+
+func (o *Adam) SetLearningRate (rate float64) {
+    o.rate = rate
+}
+
+/**
+ * Create an Adam optimizer, beta1 and beta2 are usually 0.9 and 0.999.
+ */
+func AdamFactory (rate, beta1, beta2 float64) *Adam {
+    return &Adam{
+        rate: rate,
+        beta1: beta1,
+        beta2: beta2,
+        means: state{},
+        squares: state{},
+        steps: map[int]int{},
+    }
+}
@@ -0,0 +1,90 @@
+package optimizer
+
+import (
+    "math"
+    "testing"
+)
+
+func TestSGD(t *testing.T) {
+    o := SGDFactory(0.1)
+    params := []float64{1, 2}
+    o.Update(0, params, []float64{1, -2})
+    if params[0] != 0.9 || params[1] != 2.2 {
+        t.Errorf("SGD should have stepped to {0.9, 2.2}, but is at %v", params)
+    }
+}
+
+func TestMomentum(t *testing.T) {
+    o := MomentumFactory(0.1, 0.5)
+    params := []float64{0}
+
+    // The first step is plain SGD, the second adds half of the first.
+    o.Update(0, params, []float64{1})
+    o.Update(0, params, []float64{1})
+    if math.Abs(params[0] - -0.25) > 1e-12 {
+        t.Errorf("Momentum should have stepped to -0.25, but is at %v", params[0])
+    }
+}
+
+func TestGroupsAreIndependent(t *testing.T) {
+    o := MomentumFactory(0.1, 0.5)
+    a := []float64{0}
+    b := []float64{0}
+
+    o.Update(0, a, []float64{1})
+    o.Update(1, b, []float64{1})
+    if a[0] != b[0] {
+        t.Errorf("The first step of each group should match, but got %v and %v", a[0], b[0])
+    }
+}
+
+func TestAdamFirstStep(t *testing.T) {
+    o := AdamFactory(0.01, 0.9, 0.999)
+    params := []float64{0, 0}
+
+    // Bias correction makes the first step the learning rate, whatever the
+    // size of the gradient.
+    o.Update(0, params, []float64{1000, -0.001})
+    if math.Abs(params[0] - -0.01) > 1e-6 || math.Abs(params[1] - 0.01) > 1e-4 {
+        t.Errorf("Adam's first step should be {-0.01, 0.01}, but is %v", params)
+    }
+}
+
+func TestConvergence(t *testing.T) {
+    optimizers := []Optimizer{
+        SGDFactory(0.1),
+        MomentumFactory(0.05, 0.9),
+        NesterovFactory(0.05, 0.9),
+        AdaGradFactory(0.5),
+        RMSPropFactory(0.01, 0.9),
+        AdamFactory(0.1, 0.9, 0.999),
+    }
+
+    // Minimise (x - 3)^2 starting from 0.
+    for _, o := range optimizers {
+        params := []float64{0}
+        for i := 0; i < 1000; i++ {
+            o.Update(0, params, []float64{2 * (params[0] - 3)})
+        }
+        if math.Abs(params[0] - 3) > 0.01 {
+            t.Errorf("%T should have found 3, but found %v", o, params[0])
+        }
+    }
+}
+
+func TestSetLearningRate(t *testing.T) {
+    optimizers := []Optimizer{
+        SGDFactory(0.1),
+        MomentumFactory(0.1, 0.9),
+        AdaGradFactory(0.1),
+        RMSPropFactory(0.1, 0.9),
+        AdamFactory(0.1, 0.9, 0.999),
+    }
+
+    for _, o := range optimizers {
+        o.SetLearningRate(0.5)
+        if o.LearningRate() != 0.5 {
+            t.Errorf("%T.LearningRate() == %v, want %v", o, o.LearningRate(), 0.5)
+        }
+    }
+}
@@ -0,0 +1,233 @@
+package perceptron
+
+import (
+    "fmt"
+    "github.com/josephdpurcell/go-neural-network/activation"
+    "github.com/josephdpurcell/go-neural-network/event"
+    "github.com/josephdpurcell/go-neural-network/initializer"
+    "github.com/josephdpurcell/go-neural-network/loss"
+    "github.com/josephdpurcell/go-neural-network/mat"
+    "github.com/josephdpurcell/go-neural-network/optimizer"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+var (
+    ErrInvalidSize = fmt.Errorf("perceptron: number of inputs must be above 0")
+    ErrInvalidLearningRate = fmt.Errorf("perceptron: learning rate must not be negative")
+    ErrNilActivation = fmt.Errorf("perceptron: activation must not be nil")
+    ErrNilInitializer = fmt.Errorf("perceptron: initializer must not be nil")
+)
+
+/**
+ * A Perceptron.
+ *
+ * The weights are used to apply to each input. When biased, the Perceptron
+ * keeps one extra weight that is applied to a constant input of 1, so callers
+ * do not have to append the bias to every input themselves.
+ *
+ * The optimizer determines how large the changes in guesses are, i.e.
+ * learning velocity.
+ */
+type Perceptron struct {
+    weights []float64
+    bias float64
+    biased bool
+    optimizer optimizer.Optimizer
+    activation activation.Activation
+    loss loss.Loss
+    observer event.Observer
+}
+
+/**
+ * This function adjusts each input's weight based on the error.
+ *
+ * The perceptron learning rule gives each weight a gradient of
+ * -(error * input), which the optimizer uses to change the weights. The
+ * Perceptron's loss is only used to report how wrong the guess was, which is
+ * returned and sent to the observer, if any.
+ *
+ * Nothing changes when the input is the wrong length.
+ */
+func (p *Perceptron) Train (input []float64, desired float64) (float64, error) {
+    guess, err := p.Feedforward(input)
+    if err != nil {
+        return 0, err
+    }
+    var value float64 = p.loss.Value([]float64{guess}, []float64{desired})
+    var error float64 = desired - guess
+    p.optimizer.Update(0, p.weights, mat.ScaleVec(input, -error))
+    if (p.biased) {
+        bias := []float64{p.bias}
+        p.optimizer.Update(1, bias, []float64{-error})
+        p.bias = bias[0]
+    }
+
+    event.Notify(p.observer, event.Step{
+        Input: input,
+        Desired: []float64{desired},
+        Output: []float64{guess},
+        Loss: value,
+        Weights: append([]float64{}, p.weights...),
+    })
+
+    return value, nil
+}
+
+/**
+ * Feedforward means: here are the inputs for the Perceptron, get the
+ * Perceptron to tell us the value.
+ */
+func (p Perceptron) Feedforward (input []float64) (float64, error) {
+    score, err := p.Score(input)
+    if err != nil {
+        return 0, err
+    }
+    return p.activation.Forward(score), nil
+}
+
+/**
+ * Feedforward many inputs at once.
+ */
+func (p Perceptron) FeedforwardBatch (inputs [][]float64) ([]float64, error) {
+    outputs := make([]float64, len(inputs))
+    for i := 0; i < len(inputs); i++ {
+        output, err := p.Feedforward(inputs[i])
+        if err != nil {
+            return nil, fmt.Errorf("input %v: %w", i, err)
+        }
+        outputs[i] = output
+    }
+    return outputs, nil
+}
+
+/**
+ * The raw weighted sum of the inputs, before the activation decides what to
+ * output. How far it is from the activation's threshold says how sure the
+ * Perceptron is of its answer.
+ *
+ * There must be exactly one input per weight, or a *mat.DimensionError is
+ * returned.
+ */
+func (p Perceptron) Score (input []float64) (float64, error) {
+    if err := mat.CheckLength("perceptron", len(p.weights), len(input)); err != nil {
+        return 0, err
+    }
+    var sum float64 = mat.Dot(input, p.weights)
+    if (p.biased) {
+        sum = sum + p.bias
+    }
+
+    return sum, nil
+}
+
+/**
+ * Score many inputs at once.
+ */
+func (p Perceptron) ScoreBatch (inputs [][]float64) ([]float64, error) {
+    scores := make([]float64, len(inputs))
+    for i := 0; i < len(inputs); i++ {
+        score, err := p.Score(inputs[i])
+        if err != nil {
+            return nil, fmt.Errorf("input %v: %w", i, err)
+        }
+        scores[i] = score
+    }
+    return scores, nil
+}
+
+/**
+ * Give the Perceptron a bias weight, starting at the given value.
+ */
+func (p *Perceptron) UseBias (bias float64) {
+    p.biased = true
+    p.bias = bias
+}
+
+/**
+ * Change how the Perceptron measures its error.
+ */
+func (p *Perceptron) SetLoss (l loss.Loss) {
+    p.loss = l
+}
+
+/**
+ * The learning rate the Perceptron's optimizer currently uses.
+ */
+func (p Perceptron) LearningRate () float64 {
+    return p.optimizer.LearningRate()
+}
+
+/**
+ * Change the learning rate of the Perceptron's optimizer, e.g. from a schedule.
+ */
+func (p *Perceptron) SetLearningRate (rate float64) {
+    p.optimizer.SetLearningRate(rate)
+}
+
+/**
+ * Change how the Perceptron updates its weights.
+ */
+func (p *Perceptron) SetOptimizer (o optimizer.Optimizer) {
+    p.optimizer = o
+}
+
+/**
+ * Report each training step to the observer, or to nobody when nil.
+ */
+func (p *Perceptron) SetObserver (o event.Observer) {
+    p.observer = o
+}
+
+/**
+ * Create a Perceptron with all weights set to 0.
+ *
+ * n = the number of inputs
+ * learning = the speed at which learning will happen
+ * a = what the Perceptron outputs for a weighted sum
+ *
+ * Weights are updated with plain SGD at the learning rate and the error is
+ * measured with mean squared error, until SetOptimizer or SetLoss say
+ * otherwise.
+ *
+ * ErrInvalidSize is returned when n is not above 0, ErrInvalidLearningRate
+ * when learning is negative and ErrNilActivation when a is nil.
+ */
+func PerceptronFactory (n int, learning float64, a activation.Activation) (Perceptron, error) {
+    return InitializedPerceptronFactory(n, learning, a, initializer.ZerosFactory())
+}
+
+/**
+ * Create a Perceptron with weights chosen at random between -1 and 1, drawn
+ * from the given source.
+ */
+func RandomPerceptronFactory (n int, learning float64, a activation.Activation, src *random.Source) (Perceptron, error) {
+    return InitializedPerceptronFactory(n, learning, a, initializer.UniformFactory(-1, 1, src))
+}
+
+/**
+ * Create a Perceptron whose starting weights are picked by the initializer.
+ * ErrNilInitializer is returned when init is nil.
+ */
+func InitializedPerceptronFactory (n int, learning float64, a activation.Activation, init initializer.Initializer) (Perceptron, error) {
+    if (n <= 0) {
+        return Perceptron{}, ErrInvalidSize
+    }
+    if (!(learning >= 0)) {
+        return Perceptron{}, ErrInvalidLearningRate
+    }
+    if (a == nil) {
+        return Perceptron{}, ErrNilActivation
+    }
+    if (init == nil) {
+        return Perceptron{}, ErrNilInitializer
+    }
+    weights := make([]float64, n)
+    init.Initialize(weights, n, 1)
+    p := Perceptron{
+        weights: weights,
+        optimizer: optimizer.SGDFactory(learning),
+        activation: a,
+        loss: loss.MSE{},
+    }
+    return p, nil
+}
@@ -0,0 +1,473 @@
+package perceptron
+
+import (
+    "errors"
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/activation"
+    "github.com/josephdpurcell/go-neural-network/event"
+    "github.com/josephdpurcell/go-neural-network/initializer"
+    "github.com/josephdpurcell/go-neural-network/loss"
+    "github.com/josephdpurcell/go-neural-network/mat"
+    "github.com/josephdpurcell/go-neural-network/optimizer"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+func TestPerceptronFactory(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01, activation.Sign{})
+
+    if len(p.weights) != 2 {
+        t.Errorf("PerceptronFactory(%v, %v) == %v, want %v", 2, 0.01, len(p.weights), 2)
+    }
+
+    if p.optimizer.LearningRate() != 0.01 {
+        t.Errorf("PerceptronFactory(%v, %v) == %v, want %v", 2, 0.01, p.optimizer.LearningRate(), 0.01)
+    }
+
+    if p.weights[0] != 0 || p.weights[1] != 0 {
+        t.Errorf("Weights should start at 0, but are: %v", p.weights)
+    }
+}
+
+func TestRandomPerceptronFactory(t *testing.T) {
+    p, _ := RandomPerceptronFactory(3, 0.01, activation.Sign{}, random.SourceFactory(42))
+
+    for i := 0; i < len(p.weights); i++ {
+        if p.weights[i] < -1 || p.weights[i] > 1 {
+            t.Errorf("Weight %v == %v, want between -1 and 1", i, p.weights[i])
+        }
+    }
+
+    // The same seed should give the same weights.
+    q, _ := RandomPerceptronFactory(3, 0.01, activation.Sign{}, random.SourceFactory(42))
+    for i := 0; i < len(p.weights); i++ {
+        if p.weights[i] != q.weights[i] {
+            t.Errorf("Weights %v and %v should be the same for the same seed", p.weights, q.weights)
+            break
+        }
+    }
+}
+
+func TestInitializedPerceptronFactory(t *testing.T) {
+    p, _ := InitializedPerceptronFactory(3, 0.01, activation.Sign{}, initializer.ConstantFactory(0.5))
+
+    for i := 0; i < len(p.weights); i++ {
+        if p.weights[i] != 0.5 {
+            t.Errorf("Weights should start at 0.5, but are: %v", p.weights)
+            break
+        }
+    }
+}
+
+func TestPerceptronFeedforwardNAND(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01, activation.Step{Threshold: 0.5})
+
+    p.weights[0] = 0.26
+    p.weights[1] = 0.25
+
+    input := []float64{1, 1}
+
+    got, _ := p.Feedforward(input)
+
+    if got != 1 {
+        t.Errorf("p.Feedforward(%v) == %v, want %v", input, got, 1)
+    }
+
+    p.weights[0] = 0.25
+    p.weights[1] = 0.25
+
+    input = []float64{1, 1}
+
+    got, _ = p.Feedforward(input)
+
+    if got != 0 {
+        t.Errorf("p.Feedforward(%v) == %v, want %v", input, got, 0)
+    }
+}
+
+func TestPerceptronFeedforwardFofX(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01, activation.Sign{})
+
+    var input []float64
+    var got float64
+
+    // With weights {0, 0}, {1,1} should return -1.
+    p.weights[0] = 0
+    p.weights[1] = 0
+    input = []float64{1, 1}
+    got, _ = p.Feedforward(input)
+    if got != -1 {
+        t.Errorf("p.Feedforward(%v) == %v, want %v", input, got, -1)
+    }
+
+    // With weights {0.1, 0.1}, {1,1} should return 1.
+    p.weights[0] = 0.1
+    p.weights[1] = 0.1
+    input = []float64{1, 1}
+    got, _ = p.Feedforward(input)
+    if got != 1 {
+        t.Errorf("p.Feedforward(%v) == %v, want %v", input, got, 1)
+    }
+
+    // With weights {-0.1, -0.1}, {1,1} should return -1.
+    p.weights[0] = -0.1
+    p.weights[1] = -0.1
+    input = []float64{1, 1}
+    got, _ = p.Feedforward(input)
+    if got != -1 {
+        t.Errorf("p.Feedforward(%v) == %v, want %v", input, got, -1)
+    }
+
+    // With weights {-0.2, 0.1}, {1,1} should return -1.
+    p.weights[0] = -0.2
+    p.weights[1] = 0.1
+    input = []float64{1, 1}
+    got, _ = p.Feedforward(input)
+    if got != -1 {
+        t.Errorf("p.Feedforward(%v) == %v, want %v", input, got, -1)
+    }
+}
+
+func TestPerceptronScore(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01, activation.Step{Threshold: 0.5})
+    p.weights[0] = 0.25
+    p.weights[1] = -0.5
+    p.UseBias(1)
+
+    input := []float64{2, 1}
+    got, _ := p.Score(input)
+    if got != 1 {
+        t.Errorf("p.Score(%v) == %v, want %v", input, got, 1)
+    }
+}
+
+func TestPerceptronBatch(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01, activation.Sign{})
+    p.weights[0] = 1
+    p.weights[1] = -1
+
+    inputs := [][]float64{{1, 0}, {0, 1}, {2, 1}}
+
+    got, _ := p.FeedforwardBatch(inputs)
+    want := []float64{1, -1, 1}
+    for i := 0; i < len(want); i++ {
+        if got[i] != want[i] {
+            t.Errorf("p.FeedforwardBatch(%v) == %v, want %v", inputs, got, want)
+            break
+        }
+    }
+
+    scores, _ := p.ScoreBatch(inputs)
+    want = []float64{1, -1, 1}
+    for i := 0; i < len(want); i++ {
+        if scores[i] != want[i] {
+            t.Errorf("p.ScoreBatch(%v) == %v, want %v", inputs, scores, want)
+            break
+        }
+    }
+}
+
+func TestPerceptronTrainNAND(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01, activation.Step{Threshold: 0.5})
+
+    var desired float64
+    var input []float64
+
+    // With weights {0, 0}, {1, 1} should not modify weights.
+    p.weights[0] = 0
+    p.weights[1] = 0
+    input = []float64{1, 1}
+    desired = 0
+
+    p.Train(input, desired)
+
+    if p.weights[0] != 0 || p.weights[1] != 0 {
+        t.Errorf("Weights should have remained the same, but are: %v", p.weights)
+    }
+
+    // With weights {1, 1}, {1, 1} should modify weights to {0.99, 0.99}
+    p.weights[0] = 1
+    p.weights[1] = 1
+    input = []float64{1, 1}
+    desired = 0
+
+    p.Train(input, desired)
+
+    if p.weights[0] != 0.99 || p.weights[1] != 0.99 {
+        t.Errorf("Weights should have remained the same, but are: %v", p.weights)
+    }
+
+    // With weights {0, 0}, {0, 0} should not modify weights.
+    p.weights[0] = 0
+    p.weights[1] = 0
+    input = []float64{0, 0}
+    desired = 1
+
+    p.Train(input, desired)
+
+    if p.weights[0] != 0 || p.weights[1] != 0 {
+        t.Errorf("Weights should have remained the same, but are: %v", p.weights)
+    }
+
+    // With weights {1, 1}, {0, 0} should modify weights to {1, 1}.
+    p.weights[0] = 1
+    p.weights[1] = 1
+    input = []float64{0, 0}
+    desired = 1
+
+    p.Train(input, desired)
+
+    if p.weights[0] != 1 || p.weights[1] != 1 {
+        t.Errorf("Weights should have remained the same, but are: %v", p.weights)
+    }
+
+    // With weights {0, 0}, {0, 1} should modify weights to {0, 0.01}.
+    p.weights[0] = 0
+    p.weights[1] = 0
+    input = []float64{0, 1}
+    desired = 1
+
+    p.Train(input, desired)
+
+    if p.weights[0] != 0 || p.weights[1] != 0.01 {
+        t.Errorf("Weights should have remained the same, but are: %v", p.weights)
+    }
+
+    // With weights {0, 0}, {1, 0} should modify weights to {0.01, 0}.
+    p.weights[0] = 0
+    p.weights[1] = 0
+    input = []float64{1, 0}
+    desired = 1
+
+    p.Train(input, desired)
+
+    if p.weights[0] != 0.01 || p.weights[1] != 0 {
+        t.Errorf("Weights should have remained the same, but are: %v", p.weights)
+    }
+}
+
+func TestPerceptronTrainFofX(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01, activation.Sign{})
+
+    var desired float64
+    var input []float64
+
+    // Assume f(x) = 2 * x + 1
+
+    // With weights {1, 1}, {3, 0} = 1 should not modify weights.
+    p.weights[0] = 1
+    p.weights[1] = 1
+    input = []float64{3, 0}
+    desired = 1
+    p.Train(input, desired)
+    if p.weights[0] != 1 || p.weights[1] != 1 {
+        t.Errorf("Weights should have remained the same, but are: %v", p.weights)
+    }
+
+    // With weights {1, 1}, {-3, 0} = -1 should not modify weights.
+    p.weights[0] = 1
+    p.weights[1] = 1
+    input = []float64{-3, 0}
+    desired = -1
+    p.Train(input, desired)
+    if p.weights[0] != 1 || p.weights[1] != 1 {
+        t.Errorf("Weights should have remained the same, but are: %v", p.weights)
+    }
+
+    // With weights {0, 0}, {3, 0} = 1 should set the weights to {-0.06, 0}
+    p.weights[0] = 0
+    p.weights[1] = 0
+    input = []float64{-3, 0}
+    desired = 1
+    p.Train(input, desired)
+    if p.weights[0] != -0.06 || p.weights[1] != 0 {
+        t.Errorf("Weights are wrong. They are: %v", p.weights)
+    }
+
+    // With weights {1, 1}, {-3, 0} = -1 should set the weights to {0.94, 1}
+    p.weights[0] = 1
+    p.weights[1] = 1
+    input = []float64{3, 0}
+    desired = -1
+    p.Train(input, desired)
+    if p.weights[0] != 0.94 || p.weights[1] != 1 {
+        t.Errorf("Weights are wrong. They are: %v", p.weights)
+    }
+}
+
+func TestPerceptronBias(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.1, activation.Step{Threshold: 0.5})
+    p.UseBias(0)
+
+    // Learning NAND with the bias held by the Perceptron rather than the input
+    // should follow the same trajectory as an explicit bias input of 1.
+    explicit, _ := PerceptronFactory(3, 0.1, activation.Step{Threshold: 0.5})
+    inputs := [][]float64{{0, 0}, {0, 1}, {1, 0}, {1, 1}}
+    answers := []float64{1, 1, 1, 0}
+    for epoch := 0; epoch < 10; epoch++ {
+        for i := 0; i < len(inputs); i++ {
+            p.Train(inputs[i], answers[i])
+            explicit.Train([]float64{1, inputs[i][0], inputs[i][1]}, answers[i])
+        }
+    }
+
+    if p.bias != explicit.weights[0] || p.weights[0] != explicit.weights[1] || p.weights[1] != explicit.weights[2] {
+        t.Errorf("Biased weights %v (bias %v) should match explicit weights %v", p.weights, p.bias, explicit.weights)
+    }
+
+    for i := 0; i < len(inputs); i++ {
+        got, _ := p.Feedforward(inputs[i])
+        if got != answers[i] {
+            t.Errorf("p.Feedforward(%v) == %v, want %v", inputs[i], got, answers[i])
+        }
+    }
+}
+
+func TestPerceptronTrainBaseline(t *testing.T) {
+    p, _ := PerceptronFactory(3, 0.013, activation.Identity{})
+    p.UseBias(0.2)
+
+    // Plain SGD should follow the original learning rule,
+    // weight + (input * (error * learning)). Identity makes the errors
+    // fractions, so the two may round differently in the last place.
+    inputs := [][]float64{{0.1, 0.7, -0.3}, {-0.9, 0.35, 0.6}, {0.45, -0.15, 0.8}}
+    answers := []float64{1.7, -0.4, 2.3}
+    for epoch := 0; epoch < 5; epoch++ {
+        for i := 0; i < len(inputs); i++ {
+            guess, _ := p.Feedforward(inputs[i])
+            var d float64 = (answers[i] - guess) * 0.013
+            want := make([]float64, len(p.weights))
+            for j := 0; j < len(want); j++ {
+                want[j] = p.weights[j] + (inputs[i][j] * d)
+            }
+            wantBias := p.bias + d
+
+            p.Train(inputs[i], answers[i])
+            for j := 0; j < len(want); j++ {
+                if math.Abs(p.weights[j] - want[j]) > 1e-12 {
+                    t.Errorf("Weights should be %v, but are: %v", want, p.weights)
+                    break
+                }
+            }
+            if math.Abs(p.bias - wantBias) > 1e-12 {
+                t.Errorf("Bias should be %v, but is: %v", wantBias, p.bias)
+            }
+        }
+    }
+}
+
+func TestPerceptronTrainLoss(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01, activation.Sign{})
+
+    var got float64
+
+    // A guess of -1 when 1 is desired is off by 2, so the squared error is 4.
+    got, _ = p.Train([]float64{1, 1}, 1)
+    if got != 4 {
+        t.Errorf("p.Train(%v, %v) == %v, want %v", []float64{1, 1}, 1, got, 4)
+    }
+
+    // Hinge loss of a correct guess of 1 is 0.
+    p.SetLoss(loss.Hinge{})
+    p.weights[0] = 1
+    p.weights[1] = 1
+    got, _ = p.Train([]float64{1, 1}, 1)
+    if got != 0 {
+        t.Errorf("p.Train(%v, %v) == %v, want %v", []float64{1, 1}, 1, got, 0)
+    }
+}
+
+func TestPerceptronSetOptimizer(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01, activation.Sign{})
+    p.SetOptimizer(optimizer.MomentumFactory(0.01, 0.5))
+
+    // With weights {0, 0}, {-3, 0} = 1 moves the weights to {-0.06, 0}. The
+    // second guess is correct, but momentum carries on half as far again.
+    p.Train([]float64{-3, 0}, 1)
+    p.Train([]float64{-3, 0}, 1)
+    if p.weights[0] > -0.0899 || p.weights[0] < -0.0901 || p.weights[1] != 0 {
+        t.Errorf("Weights should be {-0.09, 0}, but are: %v", p.weights)
+    }
+}
+
+func TestPerceptronObserver(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01, activation.Sign{})
+
+    var steps []event.Step
+    p.SetObserver(func (e event.Event) {
+        steps = append(steps, e.(event.Step))
+    })
+
+    // With weights {0, 0}, {-3, 0} = 1 should report a wrong guess of -1 and
+    // the new weights {-0.06, 0}.
+    p.Train([]float64{-3, 0}, 1)
+    if len(steps) != 1 {
+        t.Fatalf("Observer should have seen 1 step, but saw %v", len(steps))
+    }
+    if steps[0].Output[0] != -1 || steps[0].Loss != 4 || steps[0].Correct() {
+        t.Errorf("Step should be an incorrect guess of -1 with loss 4, but is %v", steps[0])
+    }
+    if steps[0].Weights[0] != -0.06 || steps[0].Weights[1] != 0 {
+        t.Errorf("Step weights should be {-0.06, 0}, but are %v", steps[0].Weights)
+    }
+
+    // The reported weights are a copy.
+    p.Train([]float64{3, 0}, -1)
+    if steps[0].Weights[0] != -0.06 {
+        t.Errorf("Step weights changed after the next step to %v", steps[0].Weights)
+    }
+}
+
+func TestPerceptronFactoryInvalid(t *testing.T) {
+    tests := []struct {
+        n int
+        learning float64
+        want error
+    }{
+        {0, 0.1, ErrInvalidSize},
+        {-1, 0.1, ErrInvalidSize},
+        {2, -0.1, ErrInvalidLearningRate},
+        {2, math.NaN(), ErrInvalidLearningRate},
+    }
+
+    for _, test := range tests {
+        if _, err := PerceptronFactory(test.n, test.learning, activation.Sign{}); err != test.want {
+            t.Errorf("PerceptronFactory(%v, %v) error == %v, want %v", test.n, test.learning, err, test.want)
+        }
+    }
+
+    if _, err := PerceptronFactory(2, 0, activation.Sign{}); err != nil {
+        t.Errorf("PerceptronFactory(2, 0) error == %v, want nil", err)
+    }
+    if _, err := PerceptronFactory(2, 0.1, nil); err != ErrNilActivation {
+        t.Errorf("PerceptronFactory(2, 0.1, nil) error == %v, want %v", err, ErrNilActivation)
+    }
+    if _, err := InitializedPerceptronFactory(2, 0.1, activation.Sign{}, nil); err != ErrNilInitializer {
+        t.Errorf("InitializedPerceptronFactory(2, 0.1, Sign, nil) error == %v, want %v", err, ErrNilInitializer)
+    }
+}
+
+func TestPerceptronWrongInputLength(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.1, activation.Sign{})
+    var dimension *mat.DimensionError
+
+    inputs := [][]float64{{1}, {1, 2, 3}}
+    for _, input := range inputs {
+        if _, err := p.Feedforward(input); !errors.As(err, &dimension) {
+            t.Errorf("p.Feedforward(%v) error == %v, want a *mat.DimensionError", input, err)
+        }
+
+        before := append([]float64{}, p.weights...)
+        if _, err := p.Train(input, 1); !errors.As(err, &dimension) {
+            t.Errorf("p.Train(%v) error == %v, want a *mat.DimensionError", input, err)
+        }
+        if p.weights[0] != before[0] || p.weights[1] != before[1] {
+            t.Errorf("p.Train(%v) changed the weights to %v", input, p.weights)
+        }
+    }
+
+    _, err := p.FeedforwardBatch([][]float64{{1, 1}, {1}})
+    if !errors.As(err, &dimension) || err.Error() != "input 1: perceptron: want 2 values, got 1" {
+        t.Errorf("p.FeedforwardBatch() error == %v", err)
+    }
+}
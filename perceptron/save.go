@@ -0,0 +1,123 @@
+package perceptron
+
+import (
+    "encoding/gob"
+    "encoding/json"
+    "fmt"
+    "io"
+    "github.com/josephdpurcell/go-neural-network/activation"
+    "github.com/josephdpurcell/go-neural-network/loss"
+    "github.com/josephdpurcell/go-neural-network/optimizer"
+)
+
+/**
+ * The version of the saved format. Bump it whenever the saved fields change
+ * in a way older code cannot read.
+ */
+const Version int = 1
+
+/**
+ * What gets saved of a Perceptron.
+ *
+ * Only the learning rate of the optimizer is kept, so a loaded Perceptron
+ * continues with plain SGD and mean squared error.
+ */
+type saved struct {
+    Version int `json:"version"`
+    Weights []float64 `json:"weights"`
+    Bias float64 `json:"bias"`
+    Biased bool `json:"biased"`
+    Learning float64 `json:"learning"`
+    Activation activation.Spec `json:"activation"`
+}
+
+/**
+ * Capture what needs saving.
+ */
+func (p Perceptron) save () (saved, error) {
+    spec, err := activation.Describe(p.activation)
+    if err != nil {
+        return saved{}, err
+    }
+    s := saved{
+        Version: Version,
+        Weights: p.weights,
+        Bias: p.bias,
+        Biased: p.biased,
+        Learning: p.optimizer.LearningRate(),
+        Activation: spec,
+    }
+    return s, nil
+}
+
+/**
+ * Turn what was saved back into a Perceptron.
+ */
+func (s saved) load () (Perceptron, error) {
+    if (s.Version != Version) {
+        return Perceptron{}, fmt.Errorf("perceptron: cannot load version %v, want %v", s.Version, Version)
+    }
+    if (len(s.Weights) == 0) {
+        return Perceptron{}, ErrInvalidSize
+    }
+    if (!(s.Learning >= 0)) {
+        return Perceptron{}, ErrInvalidLearningRate
+    }
+    a, err := activation.FromSpec(s.Activation)
+    if err != nil {
+        return Perceptron{}, err
+    }
+    p := Perceptron{
+        weights: s.Weights,
+        bias: s.Bias,
+        biased: s.Biased,
+        optimizer: optimizer.SGDFactory(s.Learning),
+        activation: a,
+        loss: loss.MSE{},
+    }
+    return p, nil
+}
+
+/**
+ * Save the Perceptron as JSON.
+ */
+func (p Perceptron) Save (w io.Writer) error {
+    s, err := p.save()
+    if err != nil {
+        return err
+    }
+    return json.NewEncoder(w).Encode(s)
+}
+
+/**
+ * Load a Perceptron saved as JSON.
+ */
+func Load (r io.Reader) (Perceptron, error) {
+    var s saved
+    if err := json.NewDecoder(r).Decode(&s); err != nil {
+        return Perceptron{}, err
+    }
+    return s.load()
+}
+
+/**
+ * Save the Perceptron in a compact binary form.
+ */
+func (p Perceptron) SaveBinary (w io.Writer) error {
+    s, err := p.save()
+    if err != nil {
+        return err
+    }
+    return gob.NewEncoder(w).Encode(s)
+}
+
+/**
+ * Load a Perceptron saved with SaveBinary.
+ */
+func LoadBinary (r io.Reader) (Perceptron, error) {
+    var s saved
+    if err := gob.NewDecoder(r).Decode(&s); err != nil {
+        return Perceptron{}, err
+    }
+    return s.load()
+}
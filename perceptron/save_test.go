@@ -0,0 +1,88 @@
+package perceptron
+
+import (
+    "bytes"
+    "strings"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/activation"
+)
+
+func TestSaveLoad(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.1, activation.Step{Threshold: 0.5})
+    p.weights[0] = -0.2
+    p.weights[1] = -0.1
+    p.UseBias(0.8)
+
+    var b bytes.Buffer
+    if err := p.Save(&b); err != nil {
+        t.Fatalf("p.Save() returned %v", err)
+    }
+    if !strings.Contains(b.String(), `"version":1`) {
+        t.Errorf("p.Save() should write the version, but wrote %v", b.String())
+    }
+
+    got, err := Load(&b)
+    if err != nil {
+        t.Fatalf("Load() returned %v", err)
+    }
+    assertSamePerceptron(t, got, p)
+}
+
+func TestSaveLoadBinary(t *testing.T) {
+    p, _ := RandomPerceptronFactory(3, 0.00001, activation.Sign{}, nil)
+
+    var b bytes.Buffer
+    if err := p.SaveBinary(&b); err != nil {
+        t.Fatalf("p.SaveBinary() returned %v", err)
+    }
+
+    got, err := LoadBinary(&b)
+    if err != nil {
+        t.Fatalf("LoadBinary() returned %v", err)
+    }
+    assertSamePerceptron(t, got, p)
+}
+
+func TestLoadVersion(t *testing.T) {
+    _, err := Load(strings.NewReader(`{"version": 99, "weights": [1], "activation": {"name": "sign"}}`))
+    if err == nil {
+        t.Errorf("Load() should reject an unknown version")
+    }
+}
+
+func TestLoadInvalid(t *testing.T) {
+    tests := []struct {
+        json string
+        want error
+    }{
+        {`{"version": 1, "weights": [], "activation": {"name": "sign"}}`, ErrInvalidSize},
+        {`{"version": 1, "weights": [1], "learning": -1, "activation": {"name": "sign"}}`, ErrInvalidLearningRate},
+    }
+
+    for _, test := range tests {
+        if _, err := Load(strings.NewReader(test.json)); err != test.want {
+            t.Errorf("Load(%v) error == %v, want %v", test.json, err, test.want)
+        }
+    }
+}
+
+func assertSamePerceptron(t *testing.T, got, want Perceptron) {
+    if len(got.weights) != len(want.weights) {
+        t.Fatalf("Loaded weights %v, want %v", got.weights, want.weights)
+    }
+    for i := 0; i < len(want.weights); i++ {
+        if got.weights[i] != want.weights[i] {
+            t.Errorf("Loaded weights %v, want %v", got.weights, want.weights)
+            break
+        }
+    }
+    if got.bias != want.bias || got.biased != want.biased {
+        t.Errorf("Loaded bias %v (%v), want %v (%v)", got.bias, got.biased, want.bias, want.biased)
+    }
+    if got.LearningRate() != want.LearningRate() {
+        t.Errorf("Loaded learning rate %v, want %v", got.LearningRate(), want.LearningRate())
+    }
+    if got.activation != want.activation {
+        t.Errorf("Loaded activation %v, want %v", got.activation, want.activation)
+    }
+}
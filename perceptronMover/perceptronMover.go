@@ -1,9 +1,18 @@
 package perceptronMover
 
 import (
+    "fmt"
+    "github.com/josephdpurcell/go-neural-network/initializer"
+    "github.com/josephdpurcell/go-neural-network/mat"
     "github.com/josephdpurcell/go-neural-network/pvector"
 )
 
+var (
+    ErrInvalidSize = fmt.Errorf("perceptronMover: number of forces must be above 0")
+    ErrInvalidLearningRate = fmt.Errorf("perceptronMover: learning rate must not be negative")
+    ErrNilInitializer = fmt.Errorf("perceptronMover: initializer must not be nil")
+)
+
 /**
  * A Perceptron.
  *
@@ -21,30 +30,99 @@ type Perceptron struct {
 
 /**
  * This function adjusts each input's weight based on the error.
+ *
+ * forces should be the same, unweighted forces given to Feedforward; they are
+ * only read. Nothing changes when there is not exactly one force per weight.
  */
-func (p *Perceptron) Train (forces []pvector.PVector, error pvector.PVector) {
-    for i := 0; i < len(forces); i++ {
-        p.weights[i].X = p.weights[i].X + (forces[i].X * error.X * p.learning)
-        p.weights[i].Y = p.weights[i].Y + (forces[i].Y * error.Y * p.learning)
+func (p *Perceptron) Train (forces []pvector.PVector, error pvector.PVector) error {
+    if err := p.check(forces); err != nil {
+        return err
     }
+    e := mat.RowFactory([]float64{error.X, error.Y})
+    change := matrix(forces).MulElem(e).Scale(p.learning)
+    copy(p.weights, vectors(matrix(p.weights).Add(change)))
+    return nil
 }
 
 /**
  * Feedforward means: here are the inputs for the Perceptron, get the
  * Perceptron to tell us the value.
+ *
+ * forces is left as it is, so the same forces can be passed on to Train.
+ * There must be exactly one force per weight, or a *mat.DimensionError is
+ * returned.
  */
-func (p Perceptron) Feedforward (forces []pvector.PVector) pvector.PVector {
-    var sum pvector.PVector
-
-    for i := 0; i < len(forces); i++ {
-        // Vector addition and multiplication
-        forces[i].X = forces[i].X * p.weights[i].X
-        forces[i].Y = forces[i].Y * p.weights[i].Y
-        sum = sum.Add(forces[i]);
+func (p Perceptron) Feedforward (forces []pvector.PVector) (pvector.PVector, error) {
+    if err := p.check(forces); err != nil {
+        return pvector.PVector{}, err
     }
+    weighted := matrix(forces).MulElem(matrix(p.weights))
 
     // No activation function
-    return sum
+    sum := weighted.SumCols()
+    return pvector.PVectorFactory(sum[0], sum[1]), nil
+}
+
+/**
+ * Each force scaled by its weight, i.e. what Feedforward adds up, as new
+ * vectors. forces is left as it is.
+ */
+func (p Perceptron) Weigh (forces []pvector.PVector) ([]pvector.PVector, error) {
+    if err := p.check(forces); err != nil {
+        return nil, err
+    }
+    return vectors(matrix(forces).MulElem(matrix(p.weights))), nil
+}
+
+/**
+ * How many forces the Perceptron weighs. A zero-value Perceptron weighs none,
+ * so cannot be used.
+ */
+func (p Perceptron) Inputs () int {
+    return len(p.weights)
+}
+
+/**
+ * A Perceptron with the same weights and learning rate that learns
+ * separately, i.e. training one leaves the other alone.
+ */
+func (p Perceptron) Copy () Perceptron {
+    c := Perceptron{
+        weights: append([]pvector.PVector{}, p.weights...),
+        learning: p.learning,
+    }
+    return c
+}
+
+/**
+ * Check there is one force per weight.
+ */
+func (p Perceptron) check (forces []pvector.PVector) error {
+    return mat.CheckLength("perceptronMover", len(p.weights), len(forces))
+}
+
+/**
+ * Stack vectors as the rows of an nx2 Matrix.
+ */
+func matrix (vs []pvector.PVector) mat.Matrix {
+    m := mat.MatrixFactory(len(vs), 2, nil)
+    for i := 0; i < len(vs); i++ {
+        m.Set(i, 0, vs[i].X)
+        m.Set(i, 1, vs[i].Y)
+    }
+    return m
+}
+
+/**
+ * Turn the rows of an nx2 Matrix back into vectors.
+ */
+func vectors (m mat.Matrix) []pvector.PVector {
+    rows, _ := m.Dims()
+    vs := make([]pvector.PVector, rows)
+    for i := 0; i < rows; i++ {
+        vs[i] = pvector.PVectorFactory(m.At(i, 0), m.At(i, 1))
+    }
+    return vs
 }
 
 /**
@@ -52,16 +130,41 @@ func (p Perceptron) Feedforward (forces []pvector.PVector) pvector.PVector {
  *
  * n = the number of elements in the vector
  * learning = the speed at which learning will happen
+ *
+ * ErrInvalidSize is returned when n is not above 0, and
+ * ErrInvalidLearningRate when learning is negative.
+ */
+func PerceptronFactory (n int, learning float64) (Perceptron, error) {
+    return InitializedPerceptronFactory(n, learning, initializer.ConstantFactory(1))
+}
+
+/**
+ * Create a Perceptron whose starting weights are picked by the initializer,
+ * with X and Y of each weight picked independently. ErrNilInitializer is
+ * returned when init is nil.
  */
-func PerceptronFactory (n int, learning float64) Perceptron {
+func InitializedPerceptronFactory (n int, learning float64, init initializer.Initializer) (Perceptron, error) {
+    if (n <= 0) {
+        return Perceptron{}, ErrInvalidSize
+    }
+    if (!(learning >= 0)) {
+        return Perceptron{}, ErrInvalidLearningRate
+    }
+    if (init == nil) {
+        return Perceptron{}, ErrNilInitializer
+    }
+    xs := make([]float64, n)
+    ys := make([]float64, n)
+    init.Initialize(xs, n, 1)
+    init.Initialize(ys, n, 1)
     weights := make([]pvector.PVector, n)
     for i := 0; i < n; i++ {
-        weights[i] = pvector.PVectorFactory(1, 1)
+        weights[i] = pvector.PVectorFactory(xs[i], ys[i])
     }
     p := Perceptron{
         weights: weights,
         learning: learning,
     }
-    return p
+    return p, nil
 }
 
@@ -1,12 +1,17 @@
 package perceptronMover
 
 import (
+    "errors"
+    "math"
     "testing"
+    "github.com/josephdpurcell/go-neural-network/initializer"
+    "github.com/josephdpurcell/go-neural-network/mat"
     "github.com/josephdpurcell/go-neural-network/pvector"
+    "github.com/josephdpurcell/go-neural-network/random"
 )
 
 func TestPerceptronFactory(t *testing.T) {
-    p := PerceptronFactory(2, 0.01)
+    p, _ := PerceptronFactory(2, 0.01)
 
     if len(p.weights) != 2 {
         t.Errorf("PerceptronFactory(%v, %v) == %v, want %v", 2, 0.01, len(p.weights), 2)
@@ -17,8 +22,24 @@ func TestPerceptronFactory(t *testing.T) {
     }
 }
 
+func TestInitializedPerceptronFactory(t *testing.T) {
+    p, _ := InitializedPerceptronFactory(3, 0.01, initializer.UniformFactory(-1, 1, random.SourceFactory(1)))
+
+    if len(p.weights) != 3 {
+        t.Fatalf("InitializedPerceptronFactory(%v) has %v weights, want %v", 3, len(p.weights), 3)
+    }
+    for i := 0; i < len(p.weights); i++ {
+        if p.weights[i].X < -1 || p.weights[i].X > 1 || p.weights[i].Y < -1 || p.weights[i].Y > 1 {
+            t.Errorf("Weights should be between -1 and 1, but are %v", p.weights)
+        }
+    }
+    if p.weights[0].X == p.weights[0].Y {
+        t.Errorf("X and Y should be picked independently, but are both %v", p.weights[0].X)
+    }
+}
+
 func TestPerceptronFeedforward(t *testing.T) {
-    p := PerceptronFactory(2, 0.01)
+    p, _ := PerceptronFactory(2, 0.01)
 
     var input []pvector.PVector
     var got pvector.PVector
@@ -27,7 +48,7 @@ func TestPerceptronFeedforward(t *testing.T) {
     // With weights {{0,0}, {0,0}}, {{1,1}, {1,1}} should return {0,0}.
     p.weights = []pvector.PVector{pvector.PVector{0, 0}, pvector.PVector{0, 0}}
     input = []pvector.PVector{pvector.PVector{0,0}, pvector.PVector{0,0}}
-    got = p.Feedforward(input)
+    got, _ = p.Feedforward(input)
     want = pvector.PVector{0, 0}
     if got.X != want.X || got.Y != want.Y {
         t.Errorf("p.Feedforward(%v) == %v, want %v", input, got, want)
@@ -36,7 +57,7 @@ func TestPerceptronFeedforward(t *testing.T) {
     // With weights {{1, 1}, {1, 1}}, {{1,1}, {1,1}} should return {2, 2}.
     p.weights = []pvector.PVector{pvector.PVector{1, 1}, pvector.PVector{1, 1}}
     input = []pvector.PVector{pvector.PVector{1, 1}, pvector.PVector{1, 1}}
-    got = p.Feedforward(input)
+    got, _ = p.Feedforward(input)
     want = pvector.PVector{2, 2}
     if got.X != want.X || got.Y != want.Y {
         t.Errorf("p.Feedforward(%v) == %v, want %v", input, got, want)
@@ -45,7 +66,7 @@ func TestPerceptronFeedforward(t *testing.T) {
     // With weights {{0, 1}, {0, 1}}, {{1,1}, {1,1}} should return {0, 2}.
     p.weights = []pvector.PVector{pvector.PVector{0, 1}, pvector.PVector{0, 1}}
     input = []pvector.PVector{pvector.PVector{1, 1}, pvector.PVector{1, 1}}
-    got = p.Feedforward(input)
+    got, _ = p.Feedforward(input)
     want = pvector.PVector{0, 2}
     if got.X != want.X || got.Y != want.Y {
         t.Errorf("p.Feedforward(%v) == %v, want %v", input, got, want)
@@ -54,7 +75,7 @@ func TestPerceptronFeedforward(t *testing.T) {
     // With weights {{1, 0}, {1, 0}}, {{1,1}, {1,1}} should return {2, 0}.
     p.weights = []pvector.PVector{pvector.PVector{1, 0}, pvector.PVector{1, 0}}
     input = []pvector.PVector{pvector.PVector{1, 1}, pvector.PVector{1, 1}}
-    got = p.Feedforward(input)
+    got, _ = p.Feedforward(input)
     want = pvector.PVector{2, 0}
     if got.X != want.X || got.Y != want.Y {
         t.Errorf("p.Feedforward(%v) == %v, want %v", input, got, want)
@@ -62,7 +83,7 @@ func TestPerceptronFeedforward(t *testing.T) {
 }
 
 func TestPerceptronTrain(t *testing.T) {
-    p := PerceptronFactory(2, 0.01)
+    p, _ := PerceptronFactory(2, 0.01)
 
     var input []pvector.PVector
     var error pvector.PVector
@@ -75,7 +96,7 @@ func TestPerceptronTrain(t *testing.T) {
     p.Train(input, error)
     want = []pvector.PVector{pvector.PVector{0, 0}, pvector.PVector{0, 0}}
     if p.weights[0] != want[0] || p.weights[1] != want[1] {
-        t.Errorf("p.Train(%v, %v) ==> %v, want %v", input, p.weights, want)
+        t.Errorf("p.Train(%v, %v) ==> %v, want %v", input, error, p.weights, want)
     }
 
     // With weights {{1,1}, {1,1}}, {{1,1}, {1,1}} and error {1,1}, want {{1.01,1.01}, {1.01,1.01}}
@@ -85,7 +106,7 @@ func TestPerceptronTrain(t *testing.T) {
     p.Train(input, error)
     want = []pvector.PVector{pvector.PVector{1.01, 1.01}, pvector.PVector{1.01, 1.01}}
     if p.weights[0] != want[0] || p.weights[1] != want[1] {
-        t.Errorf("p.Train(%v, %v) ==> %v, want %v", input, p.weights, want)
+        t.Errorf("p.Train(%v, %v) ==> %v, want %v", input, error, p.weights, want)
     }
 
     // With weights {{1,1}, {1,1}}, {{1,1}, {1,1}} and error {0,1}, want {{1,1.01}, {1,1.01}}
@@ -95,7 +116,101 @@ func TestPerceptronTrain(t *testing.T) {
     p.Train(input, error)
     want = []pvector.PVector{pvector.PVector{1, 1.01}, pvector.PVector{1, 1.01}}
     if p.weights[0] != want[0] || p.weights[1] != want[1] {
-        t.Errorf("p.Train(%v, %v) ==> %v, want %v", input, p.weights, want)
+        t.Errorf("p.Train(%v, %v) ==> %v, want %v", input, error, p.weights, want)
     }
 }
 
+func TestPerceptronFactoryInvalid(t *testing.T) {
+    tests := []struct {
+        n int
+        learning float64
+        want error
+    }{
+        {0, 0.01, ErrInvalidSize},
+        {-2, 0.01, ErrInvalidSize},
+        {2, -0.01, ErrInvalidLearningRate},
+    }
+
+    for _, test := range tests {
+        if _, err := PerceptronFactory(test.n, test.learning); err != test.want {
+            t.Errorf("PerceptronFactory(%v, %v) error == %v, want %v", test.n, test.learning, err, test.want)
+        }
+    }
+
+    if _, err := InitializedPerceptronFactory(2, 0.01, nil); err != ErrNilInitializer {
+        t.Errorf("InitializedPerceptronFactory(2, 0.01, nil) error == %v, want %v", err, ErrNilInitializer)
+    }
+}
+
+func TestPerceptronCopy(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01)
+    c := p.Copy()
+    if c.Inputs() != 2 || c.learning != 0.01 {
+        t.Errorf("p.Copy() weighs %v forces at %v, want 2 at 0.01", c.Inputs(), c.learning)
+    }
+
+    forces := []pvector.PVector{pvector.PVectorFactory(1, 1), pvector.PVectorFactory(1, 1)}
+    c.Train(forces, pvector.PVectorFactory(100, 100))
+    if p.weights[0] != pvector.PVectorFactory(1, 1) {
+        t.Errorf("Training a copy changed the original's weights to %v", p.weights)
+    }
+    if (Perceptron{}).Inputs() != 0 {
+        t.Errorf("A zero-value Perceptron weighs %v forces, want 0", (Perceptron{}).Inputs())
+    }
+}
+
+func TestPerceptronWrongForceCount(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01)
+    forces := []pvector.PVector{pvector.PVectorFactory(1, 1)}
+    var dimension *mat.DimensionError
+
+    if _, err := p.Feedforward(forces); !errors.As(err, &dimension) {
+        t.Errorf("p.Feedforward(%v) error == %v, want a *mat.DimensionError", forces, err)
+    }
+    if err := p.Train(forces, pvector.PVectorFactory(1, 1)); !errors.As(err, &dimension) {
+        t.Errorf("p.Train(%v) error == %v, want a *mat.DimensionError", forces, err)
+    }
+    if p.weights[0] != pvector.PVectorFactory(1, 1) || p.weights[1] != pvector.PVectorFactory(1, 1) {
+        t.Errorf("p.Train(%v) changed the weights to %v", forces, p.weights)
+    }
+}
+
+func TestPerceptronLeavesForcesAlone(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.01)
+    p.weights = []pvector.PVector{pvector.PVectorFactory(2, 3), pvector.PVectorFactory(-1, 0.5)}
+
+    forces := []pvector.PVector{pvector.PVectorFactory(1, 2), pvector.PVectorFactory(3, 4)}
+    original := append([]pvector.PVector{}, forces...)
+    unchanged := func (call string) {
+        for i := 0; i < len(original); i++ {
+            if forces[i] != original[i] {
+                t.Errorf("%v changed the forces from %v to %v", call, original, forces)
+                return
+            }
+        }
+    }
+
+    got, _ := p.Feedforward(forces)
+    unchanged("p.Feedforward()")
+    if want := pvector.PVectorFactory(-1, 8); got != want {
+        t.Errorf("p.Feedforward(%v) == %v, want %v", forces, got, want)
+    }
+
+    weighted, _ := p.Weigh(forces)
+    unchanged("p.Weigh()")
+    want := []pvector.PVector{pvector.PVectorFactory(2, 6), pvector.PVectorFactory(-3, 2)}
+    if len(weighted) != 2 || weighted[0] != want[0] || weighted[1] != want[1] {
+        t.Errorf("p.Weigh(%v) == %v, want %v", forces, weighted, want)
+    }
+
+    // Training after Feedforward learns from the original forces.
+    p.Train(forces, pvector.PVectorFactory(1, 1))
+    unchanged("p.Train()")
+    want = []pvector.PVector{pvector.PVectorFactory(2.01, 3.02), pvector.PVectorFactory(-0.97, 0.54)}
+    for i := 0; i < len(want); i++ {
+        if math.Abs(p.weights[i].X - want[i].X) > 1e-12 || math.Abs(p.weights[i].Y - want[i].Y) > 1e-12 {
+            t.Errorf("p.Train(%v) ==> %v, want %v", forces, p.weights, want)
+            break
+        }
+    }
+}
@@ -0,0 +1,93 @@
+package perceptronMover
+
+import (
+    "encoding/gob"
+    "encoding/json"
+    "fmt"
+    "io"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+)
+
+/**
+ * The version of the saved format. Bump it whenever the saved fields change
+ * in a way older code cannot read.
+ */
+const Version int = 1
+
+/**
+ * What gets saved of a Perceptron. There is no activation to save since the
+ * output is the weighted sum as-is.
+ */
+type saved struct {
+    Version int `json:"version"`
+    Weights []pvector.PVector `json:"weights"`
+    Learning float64 `json:"learning"`
+}
+
+/**
+ * Capture what needs saving.
+ */
+func (p Perceptron) save () saved {
+    s := saved{
+        Version: Version,
+        Weights: p.weights,
+        Learning: p.learning,
+    }
+    return s
+}
+
+/**
+ * Turn what was saved back into a Perceptron.
+ */
+func (s saved) load () (Perceptron, error) {
+    if (s.Version != Version) {
+        return Perceptron{}, fmt.Errorf("perceptronMover: cannot load version %v, want %v", s.Version, Version)
+    }
+    if (len(s.Weights) == 0) {
+        return Perceptron{}, ErrInvalidSize
+    }
+    if (!(s.Learning >= 0)) {
+        return Perceptron{}, ErrInvalidLearningRate
+    }
+    p := Perceptron{
+        weights: s.Weights,
+        learning: s.Learning,
+    }
+    return p, nil
+}
+
+/**
+ * Save the Perceptron as JSON.
+ */
+func (p Perceptron) Save (w io.Writer) error {
+    return json.NewEncoder(w).Encode(p.save())
+}
+
+/**
+ * Load a Perceptron saved as JSON.
+ */
+func Load (r io.Reader) (Perceptron, error) {
+    var s saved
+    if err := json.NewDecoder(r).Decode(&s); err != nil {
+        return Perceptron{}, err
+    }
+    return s.load()
+}
+
+/**
+ * Save the Perceptron in a compact binary form.
+ */
+func (p Perceptron) SaveBinary (w io.Writer) error {
+    return gob.NewEncoder(w).Encode(p.save())
+}
+
+/**
+ * Load a Perceptron saved with SaveBinary.
+ */
+func LoadBinary (r io.Reader) (Perceptron, error) {
+    var s saved
+    if err := gob.NewDecoder(r).Decode(&s); err != nil {
+        return Perceptron{}, err
+    }
+    return s.load()
+}
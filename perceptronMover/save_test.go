@@ -0,0 +1,67 @@
+package perceptronMover
+
+import (
+    "bytes"
+    "strings"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/pvector"
+)
+
+func TestSaveLoad(t *testing.T) {
+    p, _ := PerceptronFactory(2, 0.00001)
+    p.weights = []pvector.PVector{pvector.PVectorFactory(0.5, 1.5), pvector.PVectorFactory(-1, 2)}
+
+    var b bytes.Buffer
+    if err := p.Save(&b); err != nil {
+        t.Fatalf("p.Save() returned %v", err)
+    }
+
+    got, err := Load(&b)
+    if err != nil {
+        t.Fatalf("Load() returned %v", err)
+    }
+    if got.learning != p.learning || len(got.weights) != 2 || got.weights[0] != p.weights[0] || got.weights[1] != p.weights[1] {
+        t.Errorf("Load() == %v, want %v", got, p)
+    }
+}
+
+func TestSaveLoadBinary(t *testing.T) {
+    p, _ := PerceptronFactory(3, 0.01)
+    p.weights[2] = pvector.PVectorFactory(3, 4)
+
+    var b bytes.Buffer
+    if err := p.SaveBinary(&b); err != nil {
+        t.Fatalf("p.SaveBinary() returned %v", err)
+    }
+
+    got, err := LoadBinary(&b)
+    if err != nil {
+        t.Fatalf("LoadBinary() returned %v", err)
+    }
+    if got.learning != p.learning || len(got.weights) != 3 || got.weights[2] != p.weights[2] {
+        t.Errorf("LoadBinary() == %v, want %v", got, p)
+    }
+}
+
+func TestLoadVersion(t *testing.T) {
+    _, err := Load(strings.NewReader(`{"version": 2, "weights": [], "learning": 0.1}`))
+    if err == nil {
+        t.Errorf("Load() should reject an unknown version")
+    }
+}
+
+func TestLoadInvalid(t *testing.T) {
+    tests := []struct {
+        json string
+        want error
+    }{
+        {`{"version": 1, "weights": [], "learning": 0.1}`, ErrInvalidSize},
+        {`{"version": 1, "weights": [{"X": 1, "Y": 1}], "learning": -0.1}`, ErrInvalidLearningRate},
+    }
+
+    for _, test := range tests {
+        if _, err := Load(strings.NewReader(test.json)); err != test.want {
+            t.Errorf("Load(%v) error == %v, want %v", test.json, err, test.want)
+        }
+    }
+}
@@ -1,9 +1,16 @@
 package pvector
 
 import (
+    "fmt"
     "math"
+    "github.com/josephdpurcell/go-neural-network/random"
 )
 
+/**
+ * Returned by the checked operations instead of an infinite or NaN vector.
+ */
+var ErrDivideByZero = fmt.Errorf("pvector: division by zero")
+
 /**
  * A vector with X and Y values.
  */
@@ -59,6 +66,17 @@ func (v1 PVector) Div (n float64) PVector {
     return v1
 }
 
+/**
+ * Scale a vector with division, returning ErrDivideByZero rather than a vector
+ * of infinities when n is 0.
+ */
+func (v1 PVector) CheckedDiv (n float64) (PVector, error) {
+    if (n == 0) {
+        return v1, ErrDivideByZero
+    }
+    return v1.Div(n), nil
+}
+
 /**
  * Calculate the magnitude of a vector.
  */
@@ -77,12 +95,9 @@ func (v1 PVector) SetMag(mag float64) PVector {
  * Normalize the vector to a unit length of 1.
  */
 func (v1 PVector) Normalize () PVector {
-    var mag float64 = v1.Mag()
-    if (mag != 0) {
-        return v1.Div(mag)
-    } else {
-        return v1
-    }
+    // A zero vector has no direction, so it stays as it is.
+    v, _ := v1.CheckedDiv(v1.Mag())
+    return v
 }
 
 /**
@@ -96,23 +111,79 @@ func (v1 PVector) Limit (mag float64) PVector {
     }
 }
 
-/*
-heading() — the 2D heading of a vector eXpressed as an angle
-
-rotate() — rotate a 2D vector by an angle
+/**
+ * The 2D heading of a vector expressed as an angle in radians. A zero vector
+ * has a heading of 0.
+ */
+func (v1 PVector) Heading () float64 {
+    return math.Atan2(v1.Y, v1.X)
+}
 
-lerp() — linear interpolate to another vector
+/**
+ * Rotate a 2D vector by an angle in radians.
+ */
+func (v1 PVector) Rotate (theta float64) PVector {
+    cos := math.Cos(theta)
+    sin := math.Sin(theta)
+    return PVectorFactory((v1.X * cos) - (v1.Y * sin), (v1.X * sin) + (v1.Y * cos))
+}
 
-dist() — the Euclidean distance between two vectors (considered as points)
+/**
+ * Linear interpolate to another vector: 0 gives this vector, 1 gives the other
+ * and anything between is part way along the line between them.
+ */
+func (v1 PVector) Lerp (v2 PVector, amount float64) PVector {
+    return v1.Add(v2.Sub(v1).Mult(amount))
+}
 
-angleBetween() — find the angle between two vectors
+/**
+ * The Euclidean distance between two vectors (considered as points).
+ */
+func (v1 PVector) Dist (v2 PVector) float64 {
+    return v1.Sub(v2).Mag()
+}
 
-dot() — the dot product of two vectors
+/**
+ * The dot product of two vectors.
+ */
+func (v1 PVector) Dot (v2 PVector) float64 {
+    return (v1.X * v2.X) + (v1.Y * v2.Y)
+}
 
-cross() — the cross product of two vectors (only relevant in three dimensions)
+/**
+ * The cross product of two vectors, treating them as 3D vectors lying flat so
+ * only Z of the result is ever non-zero.
+ */
+func (v1 PVector) Cross (v2 PVector) VecN {
+    return Vec3Factory(v1.X, v1.Y, 0).Cross(Vec3Factory(v2.X, v2.Y, 0))
+}
 
-random2D() - make a random 2D vector
+/**
+ * Find the angle between two vectors in radians, from 0 to Pi. The angle to a
+ * zero vector is 0, since it has no direction.
+ */
+func (v1 PVector) AngleBetween (v2 PVector) float64 {
+    // Unlike Acos of the cosine, this stays accurate for nearly parallel
+    // vectors and can never be pushed out of range by rounding.
+    cross := (v1.X * v2.Y) - (v1.Y * v2.X)
+    return math.Atan2(math.Abs(cross), v1.Dot(v2))
+}
 
-random3D() - make a random 3D vector
-*/
+/**
+ * Make a random 2D vector with a magnitude of 1.
+ */
+func Random2D (src *random.Source) PVector {
+    theta := src.Random(0, 2 * math.Pi)
+    return PVectorFactory(math.Cos(theta), math.Sin(theta))
+}
 
+/**
+ * Make a random 3D vector with a magnitude of 1. Every direction is equally
+ * likely.
+ */
+func Random3D (src *random.Source) VecN {
+    theta := src.Random(0, 2 * math.Pi)
+    z := src.Random(-1, 1)
+    r := math.Sqrt(1 - (z * z))
+    return Vec3Factory(r * math.Cos(theta), r * math.Sin(theta), z)
+}
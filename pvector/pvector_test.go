@@ -0,0 +1,199 @@
+package pvector
+
+import (
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/random"
+)
+
+func close(a, b float64) bool {
+    if math.IsNaN(a) || math.IsNaN(b) {
+        return math.IsNaN(a) && math.IsNaN(b)
+    }
+    return math.Abs(a - b) < 1e-9
+}
+
+func closeVector(a, b PVector) bool {
+    return close(a.X, b.X) && close(a.Y, b.Y)
+}
+
+func TestHeading(t *testing.T) {
+    tests := []struct {
+        v PVector
+        want float64
+    }{
+        {PVectorFactory(1, 0), 0},
+        {PVectorFactory(0, 1), math.Pi / 2},
+        {PVectorFactory(-1, 0), math.Pi},
+        {PVectorFactory(1, -1), -math.Pi / 4},
+        {PVectorFactory(0, 0), 0},
+        {PVectorFactory(math.NaN(), 1), math.NaN()},
+    }
+
+    for _, test := range tests {
+        if got := test.v.Heading(); !close(got, test.want) {
+            t.Errorf("%v.Heading() == %v, want %v", test.v, got, test.want)
+        }
+    }
+}
+
+func TestRotate(t *testing.T) {
+    tests := []struct {
+        v PVector
+        theta float64
+        want PVector
+    }{
+        {PVectorFactory(1, 0), math.Pi / 2, PVectorFactory(0, 1)},
+        {PVectorFactory(1, 0), math.Pi, PVectorFactory(-1, 0)},
+        {PVectorFactory(3, 4), 2 * math.Pi, PVectorFactory(3, 4)},
+        {PVectorFactory(0, 2), -math.Pi / 2, PVectorFactory(2, 0)},
+        {PVectorFactory(0, 0), 1, PVectorFactory(0, 0)},
+    }
+
+    for _, test := range tests {
+        if got := test.v.Rotate(test.theta); !closeVector(got, test.want) {
+            t.Errorf("%v.Rotate(%v) == %v, want %v", test.v, test.theta, got, test.want)
+        }
+    }
+}
+
+func TestLerp(t *testing.T) {
+    a := PVectorFactory(0, 0)
+    b := PVectorFactory(10, -20)
+
+    tests := []struct {
+        amount float64
+        want PVector
+    }{
+        {0, a},
+        {1, b},
+        {0.5, PVectorFactory(5, -10)},
+        {2, PVectorFactory(20, -40)},
+    }
+
+    for _, test := range tests {
+        if got := a.Lerp(b, test.amount); !closeVector(got, test.want) {
+            t.Errorf("a.Lerp(b, %v) == %v, want %v", test.amount, got, test.want)
+        }
+    }
+}
+
+func TestDistDot(t *testing.T) {
+    tests := []struct {
+        a PVector
+        b PVector
+        dist float64
+        dot float64
+    }{
+        {PVectorFactory(0, 0), PVectorFactory(3, 4), 5, 0},
+        {PVectorFactory(1, 2), PVectorFactory(1, 2), 0, 5},
+        {PVectorFactory(1, 0), PVectorFactory(0, 1), math.Sqrt2, 0},
+        {PVectorFactory(2, 3), PVectorFactory(-1, 4), math.Sqrt(10), 10},
+        {PVectorFactory(math.NaN(), 0), PVectorFactory(1, 1), math.NaN(), math.NaN()},
+    }
+
+    for _, test := range tests {
+        if got := test.a.Dist(test.b); !close(got, test.dist) {
+            t.Errorf("%v.Dist(%v) == %v, want %v", test.a, test.b, got, test.dist)
+        }
+        if got := test.a.Dot(test.b); !close(got, test.dot) {
+            t.Errorf("%v.Dot(%v) == %v, want %v", test.a, test.b, got, test.dot)
+        }
+    }
+}
+
+func TestAngleBetween(t *testing.T) {
+    tests := []struct {
+        a PVector
+        b PVector
+        want float64
+    }{
+        {PVectorFactory(1, 0), PVectorFactory(0, 1), math.Pi / 2},
+        {PVectorFactory(1, 0), PVectorFactory(-2, 0), math.Pi},
+        {PVectorFactory(1, 1), PVectorFactory(2, 2), 0},
+        {PVectorFactory(1, 0), PVectorFactory(0, -1), math.Pi / 2},
+        {PVectorFactory(0, 0), PVectorFactory(1, 0), 0},
+        {PVectorFactory(1, 0), PVectorFactory(0, 0), 0},
+        // Nearly parallel vectors whose cosine rounds to just under 1.
+        {PVectorFactory(0.1, 0.2), PVectorFactory(0.3, 0.6), 0},
+        {PVectorFactory(math.NaN(), 0), PVectorFactory(1, 0), math.NaN()},
+    }
+
+    for _, test := range tests {
+        if got := test.a.AngleBetween(test.b); !close(got, test.want) {
+            t.Errorf("%v.AngleBetween(%v) == %v, want %v", test.a, test.b, got, test.want)
+        }
+    }
+}
+
+func TestCross(t *testing.T) {
+    tests := []struct {
+        a PVector
+        b PVector
+        want VecN
+    }{
+        {PVectorFactory(1, 0), PVectorFactory(0, 1), VecN{0, 0, 1}},
+        {PVectorFactory(0, 1), PVectorFactory(1, 0), VecN{0, 0, -1}},
+        {PVectorFactory(2, 3), PVectorFactory(4, 6), VecN{0, 0, 0}},
+        {PVectorFactory(0, 0), PVectorFactory(5, 5), VecN{0, 0, 0}},
+    }
+
+    for _, test := range tests {
+        if got := test.a.Cross(test.b); !equal(got, test.want) {
+            t.Errorf("%v.Cross(%v) == %v, want %v", test.a, test.b, got, test.want)
+        }
+    }
+
+    got := Vec3Factory(1, 2, 3).Cross(Vec3Factory(4, 5, 6))
+    if !equal(got, VecN{-3, 6, -3}) {
+        t.Errorf("VecN Cross == %v, want %v", got, VecN{-3, 6, -3})
+    }
+    if got := Vec3Factory(1, 2, 3).Dot(Vec3Factory(4, 5, 6)); got != 32 {
+        t.Errorf("VecN Dot == %v, want %v", got, 32)
+    }
+}
+
+func TestRandom(t *testing.T) {
+    src := random.SourceFactory(1)
+    for i := 0; i < 100; i++ {
+        v := Random2D(src)
+        if !close(v.Mag(), 1) {
+            t.Errorf("Random2D() == %v, want a magnitude of 1", v)
+        }
+        w := Random3D(src)
+        if w.Dim() != 3 || !close(w.Mag(), 1) {
+            t.Errorf("Random3D() == %v, want a 3D magnitude of 1", w)
+        }
+    }
+
+    if Random2D(random.SourceFactory(7)) != Random2D(random.SourceFactory(7)) {
+        t.Errorf("Random2D() should be the same for the same seed")
+    }
+}
+
+func TestCheckedDiv(t *testing.T) {
+    tests := []struct {
+        v PVector
+        n float64
+        want PVector
+        err error
+    }{
+        {PVectorFactory(4, -2), 2, PVectorFactory(2, -1), nil},
+        {PVectorFactory(4, -2), 0, PVectorFactory(4, -2), ErrDivideByZero},
+        {PVectorFactory(0, 0), 0, PVectorFactory(0, 0), ErrDivideByZero},
+    }
+
+    for _, test := range tests {
+        got, err := test.v.CheckedDiv(test.n)
+        if got != test.want || err != test.err {
+            t.Errorf("%v.CheckedDiv(%v) == %v, %v, want %v, %v", test.v, test.n, got, err, test.want, test.err)
+        }
+    }
+
+    if _, err := Vec3Factory(1, 2, 3).CheckedDiv(0); err != ErrDivideByZero {
+        t.Errorf("VecN CheckedDiv(0) error == %v, want %v", err, ErrDivideByZero)
+    }
+    if got, err := Vec3Factory(2, 4, 6).CheckedDiv(2); err != nil || !equal(got, VecN{1, 2, 3}) {
+        t.Errorf("VecN CheckedDiv(2) == %v, %v, want %v, nil", got, err, VecN{1, 2, 3})
+    }
+}
@@ -0,0 +1,239 @@
+package pvector
+
+import (
+    "math"
+    "github.com/josephdpurcell/go-neural-network/mat"
+)
+
+/**
+ * A vector with any number of dimensions, e.g. VecN{x, y, z} in 3D.
+ *
+ * Like PVector, every operation returns a new vector and leaves the original
+ * alone. Operations on two vectors panic with a *mat.DimensionError if their
+ * dimensions differ; the Checked versions return it instead.
+ */
+type VecN []float64
+
+/**
+ * The means of creating a VecN from its components.
+ */
+func VecNFactory (components ...float64) VecN {
+    v := make(VecN, len(components))
+    copy(v, components)
+    return v
+}
+
+/**
+ * The means of creating a 3D VecN.
+ */
+func Vec3Factory (X, Y, Z float64) VecN {
+    return VecNFactory(X, Y, Z)
+}
+
+/**
+ * The number of dimensions.
+ */
+func (v1 VecN) Dim () int {
+    return len(v1)
+}
+
+/**
+ * Add a vector.
+ */
+func (v1 VecN) Add (v2 VecN) VecN {
+    return must(v1.CheckedAdd(v2))
+}
+
+/**
+ * Add a vector, returning a *mat.DimensionError if the dimensions differ.
+ */
+func (v1 VecN) CheckedAdd (v2 VecN) (VecN, error) {
+    if err := sameDim(v1, v2); err != nil {
+        return nil, err
+    }
+    v := make(VecN, len(v1))
+    for i := 0; i < len(v1); i++ {
+        v[i] = v1[i] + v2[i]
+    }
+    return v, nil
+}
+
+/**
+ * Subtract a vector.
+ */
+func (v1 VecN) Sub (v2 VecN) VecN {
+    return must(v1.CheckedSub(v2))
+}
+
+/**
+ * Subtract a vector, returning a *mat.DimensionError if the dimensions differ.
+ */
+func (v1 VecN) CheckedSub (v2 VecN) (VecN, error) {
+    if err := sameDim(v1, v2); err != nil {
+        return nil, err
+    }
+    v := make(VecN, len(v1))
+    for i := 0; i < len(v1); i++ {
+        v[i] = v1[i] - v2[i]
+    }
+    return v, nil
+}
+
+/**
+ * Scale a vector with multiplication.
+ */
+func (v1 VecN) Mult (n float64) VecN {
+    v := make(VecN, len(v1))
+    for i := 0; i < len(v1); i++ {
+        v[i] = v1[i] * n
+    }
+    return v
+}
+
+/**
+ * Scale a vector with division.
+ */
+func (v1 VecN) Div (n float64) VecN {
+    v := make(VecN, len(v1))
+    for i := 0; i < len(v1); i++ {
+        v[i] = v1[i] / n
+    }
+    return v
+}
+
+/**
+ * Scale a vector with division, returning ErrDivideByZero rather than a vector
+ * of infinities when n is 0.
+ */
+func (v1 VecN) CheckedDiv (n float64) (VecN, error) {
+    if (n == 0) {
+        return VecNFactory(v1...), ErrDivideByZero
+    }
+    return v1.Div(n), nil
+}
+
+/**
+ * Calculate the magnitude of a vector.
+ */
+func (v1 VecN) Mag () float64 {
+    var sum float64 = 0
+    for i := 0; i < len(v1); i++ {
+        sum = sum + (v1[i] * v1[i])
+    }
+    return math.Sqrt(sum)
+}
+
+/**
+ * Set the magnitude of a vector.
+ */
+func (v1 VecN) SetMag (mag float64) VecN {
+    return v1.Normalize().Mult(mag)
+}
+
+/**
+ * Normalize the vector to a unit length of 1.
+ */
+func (v1 VecN) Normalize () VecN {
+    // A zero vector has no direction, so it stays as it is.
+    v, _ := v1.CheckedDiv(v1.Mag())
+    return v
+}
+
+/**
+ * Limit the magnitude of a vector.
+ */
+func (v1 VecN) Limit (mag float64) VecN {
+    if (v1.Mag() > mag) {
+        return v1.SetMag(mag)
+    } else {
+        return VecNFactory(v1...)
+    }
+}
+
+/**
+ * The dot product of two vectors.
+ */
+func (v1 VecN) Dot (v2 VecN) float64 {
+    sum, err := v1.CheckedDot(v2)
+    if err != nil {
+        panic(err)
+    }
+    return sum
+}
+
+/**
+ * The dot product of two vectors, returning a *mat.DimensionError if the
+ * dimensions differ.
+ */
+func (v1 VecN) CheckedDot (v2 VecN) (float64, error) {
+    if err := sameDim(v1, v2); err != nil {
+        return 0, err
+    }
+    var sum float64 = 0
+    for i := 0; i < len(v1); i++ {
+        sum = sum + (v1[i] * v2[i])
+    }
+    return sum, nil
+}
+
+/**
+ * The cross product of two vectors. Only defined in three dimensions, so it
+ * panics for anything else.
+ */
+func (v1 VecN) Cross (v2 VecN) VecN {
+    return must(v1.CheckedCross(v2))
+}
+
+/**
+ * The cross product of two vectors, returning a *mat.DimensionError unless
+ * both have three dimensions.
+ */
+func (v1 VecN) CheckedCross (v2 VecN) (VecN, error) {
+    if err := mat.CheckLength("pvector: cross", 3, len(v1)); err != nil {
+        return nil, err
+    }
+    if err := sameDim(v1, v2); err != nil {
+        return nil, err
+    }
+    return Vec3Factory(
+        (v1[1] * v2[2]) - (v1[2] * v2[1]),
+        (v1[2] * v2[0]) - (v1[0] * v2[2]),
+        (v1[0] * v2[1]) - (v1[1] * v2[0]),
+    ), nil
+}
+
+/**
+ * Convert to a VecN with X and Y as its two components.
+ */
+func (v1 PVector) VecN () VecN {
+    return VecNFactory(v1.X, v1.Y)
+}
+
+/**
+ * Convert the first two components to a PVector, using 0 for any that are
+ * missing.
+ */
+func (v1 VecN) PVector () PVector {
+    var p PVector
+    if (len(v1) > 0) {
+        p.X = v1[0]
+    }
+    if (len(v1) > 1) {
+        p.Y = v1[1]
+    }
+    return p
+}
+
+func sameDim (v1, v2 VecN) error {
+    return mat.CheckLength("pvector", len(v1), len(v2))
+}
+
+/**
+ * The vector, or a panic with the error of a Checked operation.
+ */
+func must (v VecN, err error) VecN {
+    if err != nil {
+        panic(err)
+    }
+    return v
+}
@@ -0,0 +1,129 @@
+package pvector
+
+import (
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/mat"
+)
+
+func equal(a, b VecN) bool {
+    if len(a) != len(b) {
+        return false
+    }
+    for i := 0; i < len(a); i++ {
+        if math.Abs(a[i] - b[i]) > 1e-12 {
+            return false
+        }
+    }
+    return true
+}
+
+func TestVecN(t *testing.T) {
+    a := Vec3Factory(1, 2, 2)
+    b := VecNFactory(3, 0, -1)
+
+    tests := []struct {
+        name string
+        got VecN
+        want VecN
+    }{
+        {"Add", a.Add(b), VecN{4, 2, 1}},
+        {"Sub", a.Sub(b), VecN{-2, 2, 3}},
+        {"Mult", a.Mult(2), VecN{2, 4, 4}},
+        {"Div", a.Div(2), VecN{0.5, 1, 1}},
+        {"Normalize", a.Normalize(), VecN{1.0 / 3, 2.0 / 3, 2.0 / 3}},
+        {"Normalize zero", VecN{0, 0, 0}.Normalize(), VecN{0, 0, 0}},
+        {"SetMag", a.SetMag(6), VecN{2, 4, 4}},
+        {"Limit over", a.Limit(1.5), VecN{0.5, 1, 1}},
+        {"Limit under", a.Limit(10), VecN{1, 2, 2}},
+        {"4D", VecN{1, 1, 1, 1}.Add(VecN{1, 2, 3, 4}), VecN{2, 3, 4, 5}},
+    }
+
+    for _, test := range tests {
+        if !equal(test.got, test.want) {
+            t.Errorf("%v == %v, want %v", test.name, test.got, test.want)
+        }
+    }
+
+    if a.Mag() != 3 {
+        t.Errorf("a.Mag() == %v, want %v", a.Mag(), 3)
+    }
+    if a.Dim() != 3 {
+        t.Errorf("a.Dim() == %v, want %v", a.Dim(), 3)
+    }
+    if !equal(a, VecN{1, 2, 2}) {
+        t.Errorf("Operations changed a to %v", a)
+    }
+}
+
+func TestVecNCopies(t *testing.T) {
+    components := []float64{1, 2}
+    v := VecNFactory(components...)
+    components[0] = 5
+    if v[0] != 1 {
+        t.Errorf("VecNFactory() shares its components, v[0] == %v", v[0])
+    }
+
+    limited := v.Limit(10)
+    limited[0] = 5
+    if v[0] != 1 {
+        t.Errorf("Limit() shares its components, v[0] == %v", v[0])
+    }
+}
+
+func TestVecNDimensionMismatch(t *testing.T) {
+    defer func() {
+        if _, ok := recover().(*mat.DimensionError); !ok {
+            t.Errorf("Adding vectors of different dimensions should panic with a *mat.DimensionError")
+        }
+    }()
+    Vec3Factory(1, 2, 3).Add(VecN{1, 2})
+}
+
+func TestVecNChecked(t *testing.T) {
+    a := Vec3Factory(1, 2, 3)
+    b := Vec3Factory(4, 5, 6)
+
+    if got, err := a.CheckedAdd(b); err != nil || !equal(got, VecN{5, 7, 9}) {
+        t.Errorf("a.CheckedAdd(b) == %v, %v, want %v", got, err, VecN{5, 7, 9})
+    }
+    if got, err := a.CheckedSub(b); err != nil || !equal(got, VecN{-3, -3, -3}) {
+        t.Errorf("a.CheckedSub(b) == %v, %v, want %v", got, err, VecN{-3, -3, -3})
+    }
+    if got, err := a.CheckedDot(b); err != nil || got != 32 {
+        t.Errorf("a.CheckedDot(b) == %v, %v, want %v", got, err, 32)
+    }
+    if got, err := a.CheckedCross(b); err != nil || !equal(got, VecN{-3, 6, -3}) {
+        t.Errorf("a.CheckedCross(b) == %v, %v, want %v", got, err, VecN{-3, 6, -3})
+    }
+
+    short := VecN{1, 2}
+    if _, err := a.CheckedAdd(short); err == nil {
+        t.Errorf("CheckedAdd() of 3 and 2 dimensions should return an error")
+    }
+    if _, err := a.CheckedSub(short); err == nil {
+        t.Errorf("CheckedSub() of 3 and 2 dimensions should return an error")
+    }
+    if _, err := a.CheckedDot(short); err == nil {
+        t.Errorf("CheckedDot() of 3 and 2 dimensions should return an error")
+    }
+    if _, err := a.CheckedCross(short); err == nil {
+        t.Errorf("CheckedCross() of 3 and 2 dimensions should return an error")
+    }
+    if _, err := short.CheckedCross(short); err == nil {
+        t.Errorf("CheckedCross() in 2 dimensions should return an error")
+    }
+}
+
+func TestVecNPVector(t *testing.T) {
+    p := PVectorFactory(3, 4)
+    if !equal(p.VecN(), VecN{3, 4}) {
+        t.Errorf("p.VecN() == %v, want %v", p.VecN(), VecN{3, 4})
+    }
+    if got := Vec3Factory(3, 4, 5).PVector(); got != p {
+        t.Errorf("VecN.PVector() == %v, want %v", got, p)
+    }
+    if got := (VecN{7}).PVector(); got != PVectorFactory(7, 0) {
+        t.Errorf("VecN{7}.PVector() == %v, want %v", got, PVectorFactory(7, 0))
+    }
+}
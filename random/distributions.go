@@ -0,0 +1,204 @@
+package random
+
+import (
+    "math"
+    "math/rand"
+)
+
+/**
+ * A number from a normal (Gaussian) distribution.
+ */
+func (s *Source) Gaussian (mean, stddev float64) float64 {
+    var n float64
+    if (s == nil) {
+        n = rand.NormFloat64()
+    } else {
+        n = s.r.NormFloat64()
+    }
+    return mean + (n * stddev)
+}
+
+/**
+ * A number from a normal distribution, drawn again until it is between min
+ * and max.
+ *
+ * If the range is so far from the mean that nothing lands in it after many
+ * tries, a number from the range is chosen uniformly instead.
+ */
+func (s *Source) TruncatedNormal (mean, stddev, min, max float64) float64 {
+    for i := 0; i < 1000; i++ {
+        x := s.Gaussian(mean, stddev)
+        if (x >= min && x <= max) {
+            return x
+        }
+    }
+    return s.Random(min, max)
+}
+
+/**
+ * True with probability p.
+ */
+func (s *Source) Bernoulli (p float64) bool {
+    return s.Float64() < p
+}
+
+/**
+ * Choose an index with probability proportional to its weight, e.g. weights
+ * of {1, 3} choose 1 three times as often as 0.
+ *
+ * Returns -1 when no weight is above 0.
+ */
+func (s *Source) Categorical (weights []float64) int {
+    var total float64 = 0
+    for i := 0; i < len(weights); i++ {
+        if (weights[i] > 0) {
+            total = total + weights[i]
+        }
+    }
+    if (total <= 0) {
+        return -1
+    }
+
+    x := s.Float64() * total
+    var last int = -1
+    for i := 0; i < len(weights); i++ {
+        if (weights[i] <= 0) {
+            continue
+        }
+        last = i
+        x = x - weights[i]
+        if (x < 0) {
+            return i
+        }
+    }
+    // Rounding can leave a sliver past the end.
+    return last
+}
+
+/**
+ * A number from an exponential distribution, e.g. the time between events
+ * that happen rate times per unit of time on average.
+ */
+func (s *Source) Exponential (rate float64) float64 {
+    var n float64
+    if (s == nil) {
+        n = rand.ExpFloat64()
+    } else {
+        n = s.r.ExpFloat64()
+    }
+    return n / rate
+}
+
+/**
+ * A count from a Poisson distribution, e.g. how many events happen in a unit
+ * of time when lambda happen on average.
+ *
+ * For large lambda this uses the normal approximation, which is close enough
+ * and does not slow down as lambda grows.
+ */
+func (s *Source) Poisson (lambda float64) int {
+    if (lambda <= 0) {
+        return 0
+    }
+    if (lambda > 30) {
+        n := math.Floor(s.Gaussian(lambda, math.Sqrt(lambda)) + 0.5)
+        return int(math.Max(n, 0))
+    }
+
+    // Knuth: multiply uniform numbers until the product drops below e^-lambda.
+    limit := math.Exp(-lambda)
+    var k int = 0
+    var p float64 = s.Float64()
+    for p > limit {
+        k++
+        p = p * s.Float64()
+    }
+    return k
+}
+
+/**
+ * Perlin noise: smooth randomness where nearby points get similar values, as
+ * used in The Nature of Code for natural looking wandering.
+ */
+type Perlin struct {
+    perm [512]int
+}
+
+/**
+ * Create a Perlin noise generator, shuffled by the given source.
+ */
+func PerlinFactory (src *Source) *Perlin {
+    p := &Perlin{}
+    order := src.Perm(256)
+    for i := 0; i < 512; i++ {
+        p.perm[i] = order[i % 256]
+    }
+    return p
+}
+
+/**
+ * Noise along a line, between 0 and 1.
+ */
+func (p *Perlin) Noise1D (x float64) float64 {
+    return p.Noise2D(x, 0)
+}
+
+/**
+ * Noise across a plane, between 0 and 1.
+ */
+func (p *Perlin) Noise2D (x, y float64) float64 {
+    xi := int(math.Floor(x)) & 255
+    yi := int(math.Floor(y)) & 255
+    x = x - math.Floor(x)
+    y = y - math.Floor(y)
+    u := fade(x)
+    v := fade(y)
+
+    aa := p.perm[p.perm[xi] + yi]
+    ab := p.perm[p.perm[xi] + yi + 1]
+    ba := p.perm[p.perm[xi + 1] + yi]
+    bb := p.perm[p.perm[xi + 1] + yi + 1]
+
+    n := lerp(v,
+        lerp(u, grad(aa, x, y), grad(ba, x - 1, y)),
+        lerp(u, grad(ab, x, y - 1), grad(bb, x - 1, y - 1)))
+
+    // The corners' gradients keep n within about -1 and 1.
+    return math.Min(math.Max((n + 1) / 2, 0), 1)
+}
+
+/**
+ * Ease a fraction so noise changes smoothly across grid lines.
+ */
+func fade (t float64) float64 {
+    return t * t * t * ((t * ((t * 6) - 15)) + 10)
+}
+
+func lerp (t, a, b float64) float64 {
+    return a + (t * (b - a))
+}
+
+/**
+ * The dot product of one of eight gradient directions with the distance
+ * from the grid corner.
+ */
+func grad (hash int, x, y float64) float64 {
+    switch hash & 7 {
+    case 0:
+        return x + y
+    case 1:
+        return -x + y
+    case 2:
+        return x - y
+    case 3:
+        return -x - y
+    case 4:
+        return x
+    case 5:
+        return -x
+    case 6:
+        return y
+    default:
+        return -y
+    }
+}
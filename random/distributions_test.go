@@ -0,0 +1,143 @@
+package random
+
+import (
+    "math"
+    "testing"
+)
+
+const samples int = 20000
+
+func TestGaussian(t *testing.T) {
+    s := SourceFactory(1)
+
+    var sum, squares float64 = 0, 0
+    for i := 0; i < samples; i++ {
+        x := s.Gaussian(5, 2)
+        sum = sum + x
+        squares = squares + (x * x)
+    }
+    mean := sum / float64(samples)
+    stddev := math.Sqrt((squares / float64(samples)) - (mean * mean))
+
+    if math.Abs(mean - 5) > 0.1 || math.Abs(stddev - 2) > 0.1 {
+        t.Errorf("Gaussian(5, 2) had mean %v and stddev %v", mean, stddev)
+    }
+}
+
+func TestTruncatedNormal(t *testing.T) {
+    s := SourceFactory(1)
+
+    for i := 0; i < 1000; i++ {
+        x := s.TruncatedNormal(0, 1, -0.5, 0.5)
+        if x < -0.5 || x > 0.5 {
+            t.Fatalf("TruncatedNormal(0, 1, -0.5, 0.5) == %v", x)
+        }
+    }
+
+    // Far from the mean should still land in the range.
+    x := s.TruncatedNormal(0, 1, 100, 101)
+    if x < 100 || x > 101 {
+        t.Errorf("TruncatedNormal(0, 1, 100, 101) == %v", x)
+    }
+}
+
+func TestBernoulli(t *testing.T) {
+    s := SourceFactory(1)
+
+    var count int = 0
+    for i := 0; i < samples; i++ {
+        if s.Bernoulli(0.3) {
+            count++
+        }
+    }
+    p := float64(count) / float64(samples)
+    if math.Abs(p - 0.3) > 0.02 {
+        t.Errorf("Bernoulli(0.3) was true %v of the time", p)
+    }
+
+    if s.Bernoulli(0) || !s.Bernoulli(1) {
+        t.Errorf("Bernoulli(0) should never be true and Bernoulli(1) always")
+    }
+}
+
+func TestCategorical(t *testing.T) {
+    s := SourceFactory(1)
+
+    counts := make([]int, 4)
+    for i := 0; i < samples; i++ {
+        counts[s.Categorical([]float64{1, 0, 3, -2})]++
+    }
+    if counts[1] != 0 || counts[3] != 0 {
+        t.Errorf("Categorical should never choose a weight of 0 or less, but counted %v", counts)
+    }
+    ratio := float64(counts[2]) / float64(counts[0])
+    if math.Abs(ratio - 3) > 0.3 {
+        t.Errorf("Categorical({1, 0, 3}) chose 2 %v times as often as 0, want 3", ratio)
+    }
+
+    if got := s.Categorical([]float64{0, 0}); got != -1 {
+        t.Errorf("Categorical({0, 0}) == %v, want %v", got, -1)
+    }
+}
+
+func TestExponential(t *testing.T) {
+    s := SourceFactory(1)
+
+    var sum float64 = 0
+    for i := 0; i < samples; i++ {
+        x := s.Exponential(4)
+        if x < 0 {
+            t.Fatalf("Exponential(4) == %v, want at least 0", x)
+        }
+        sum = sum + x
+    }
+    mean := sum / float64(samples)
+    if math.Abs(mean - 0.25) > 0.01 {
+        t.Errorf("Exponential(4) had mean %v, want %v", mean, 0.25)
+    }
+}
+
+func TestPoisson(t *testing.T) {
+    s := SourceFactory(1)
+
+    for _, lambda := range []float64{3, 100} {
+        var sum float64 = 0
+        for i := 0; i < samples; i++ {
+            sum = sum + float64(s.Poisson(lambda))
+        }
+        mean := sum / float64(samples)
+        if math.Abs(mean - lambda) > lambda * 0.02 {
+            t.Errorf("Poisson(%v) had mean %v", lambda, mean)
+        }
+    }
+
+    if got := s.Poisson(0); got != 0 {
+        t.Errorf("Poisson(0) == %v, want %v", got, 0)
+    }
+}
+
+func TestPerlin(t *testing.T) {
+    a := PerlinFactory(SourceFactory(1))
+    b := PerlinFactory(SourceFactory(1))
+
+    var previous float64 = a.Noise1D(0)
+    for i := 1; i < 1000; i++ {
+        x := float64(i) * 0.01
+        n := a.Noise1D(x)
+        if n < 0 || n > 1 {
+            t.Fatalf("Noise1D(%v) == %v, want between 0 and 1", x, n)
+        }
+        if math.Abs(n - previous) > 0.05 {
+            t.Errorf("Noise1D should change smoothly, but went from %v to %v at %v", previous, n, x)
+        }
+        if n != b.Noise1D(x) {
+            t.Fatalf("Noise from the same seed should match at %v", x)
+        }
+        previous = n
+    }
+
+    n := a.Noise2D(-3.7, 12.2)
+    if n < 0 || n > 1 {
+        t.Errorf("Noise2D(-3.7, 12.2) == %v, want between 0 and 1", n)
+    }
+}
@@ -0,0 +1,74 @@
+package random
+
+import (
+    "math/rand"
+)
+
+/**
+ * A source of random numbers that gives the same numbers every time it is
+ * created with the same seed, so training can be repeated.
+ *
+ * Anything that accepts a *Source also accepts nil, which means the global
+ * source used by Random, i.e. different numbers every run.
+ */
+type Source struct {
+    r *rand.Rand
+}
+
+/**
+ * Create a Source from a seed.
+ */
+func SourceFactory (seed int64) *Source {
+    s := &Source{
+        r: rand.New(rand.NewSource(seed)),
+    }
+    return s
+}
+
+/**
+ * A random float64 in [0, 1).
+ */
+func (s *Source) Float64 () float64 {
+    if (s == nil) {
+        return rand.Float64()
+    }
+    return s.r.Float64()
+}
+
+/**
+ * Generate a random float64 between max and min.
+ */
+func (s *Source) Random (min, max float64) float64 {
+    return (s.Float64() * (max - min)) + min
+}
+
+/**
+ * A random int in [0, n).
+ */
+func (s *Source) Intn (n int) int {
+    if (s == nil) {
+        return rand.Intn(n)
+    }
+    return s.r.Intn(n)
+}
+
+/**
+ * The numbers 0 to n-1 in a random order.
+ */
+func (s *Source) Perm (n int) []int {
+    if (s == nil) {
+        return rand.Perm(n)
+    }
+    return s.r.Perm(n)
+}
+
+/**
+ * Put n things in a random order, using swap to swap two of them.
+ */
+func (s *Source) Shuffle (n int, swap func (i, j int)) {
+    if (s == nil) {
+        rand.Shuffle(n, swap)
+        return
+    }
+    s.r.Shuffle(n, swap)
+}
@@ -0,0 +1,52 @@
+package random
+
+import "testing"
+
+func TestSourceRepeats(t *testing.T) {
+    a := SourceFactory(42)
+    b := SourceFactory(42)
+
+    for i := 0; i < 10; i++ {
+        x := a.Random(-1, 1)
+        y := b.Random(-1, 1)
+        if x != y {
+            t.Fatalf("Sources with the same seed gave %v and %v", x, y)
+        }
+        if x < -1 || x > 1 {
+            t.Errorf("s.Random(-1, 1) == %v, want between -1 and 1", x)
+        }
+    }
+
+    pa := a.Perm(10)
+    pb := b.Perm(10)
+    for i := 0; i < len(pa); i++ {
+        if pa[i] != pb[i] {
+            t.Fatalf("Sources with the same seed gave permutations %v and %v", pa, pb)
+        }
+    }
+}
+
+func TestSourceSeeds(t *testing.T) {
+    a := SourceFactory(1)
+    b := SourceFactory(2)
+
+    if a.Float64() == b.Float64() {
+        t.Errorf("Sources with different seeds should give different numbers")
+    }
+}
+
+func TestNilSource(t *testing.T) {
+    var s *Source
+
+    got := s.Random(10, 20)
+    if got < 10 || got > 20 {
+        t.Errorf("s.Random(10, 20) == %v, want between 10 and 20", got)
+    }
+    if n := s.Intn(3); n < 0 || n >= 3 {
+        t.Errorf("s.Intn(3) == %v, want between 0 and 2", n)
+    }
+    if p := s.Perm(4); len(p) != 4 {
+        t.Errorf("s.Perm(4) == %v, want 4 numbers", p)
+    }
+    s.Shuffle(2, func (i, j int) {})
+}
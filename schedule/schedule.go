@@ -0,0 +1,209 @@
+package schedule
+
+import (
+    "fmt"
+    "math"
+)
+
+var (
+    ErrInvalidEvery = fmt.Errorf("schedule: every must be above 0 epochs")
+    ErrInvalidPeriod = fmt.Errorf("schedule: period must be above 0 epochs")
+)
+
+/**
+ * A schedule decides the learning rate for each epoch.
+ *
+ * Epochs count from 0. The loss is the most recent epoch's loss, or NaN when
+ * no epoch has finished yet. Most schedules ignore it but some, like
+ * ReduceOnPlateau, react to it.
+ */
+type Schedule interface {
+    Rate (epoch int, loss float64) float64
+}
+
+/**
+ * Anything with a learning rate a schedule can adjust, e.g. an optimizer,
+ * Perceptron or Network.
+ */
+type Adjustable interface {
+    SetLearningRate (rate float64)
+}
+
+/**
+ * Set the learning rate for the given epoch, returning the rate that was set.
+ */
+func Apply (s Schedule, a Adjustable, epoch int, loss float64) float64 {
+    rate := s.Rate(epoch, loss)
+    a.SetLearningRate(rate)
+    return rate
+}
+
+/**
+ * Keep the learning rate the same every epoch.
+ */
+type Constant struct {
+    initial float64
+}
+
+func (s Constant) Rate (epoch int, loss float64) float64 {
+    return s.initial
+}
+
+/**
+ * Create a schedule that never changes the learning rate.
+ */
+func ConstantFactory (initial float64) Constant {
+    return Constant{initial: initial}
+}
+
+/**
+ * Multiply the learning rate by drop once every so many epochs.
+ */
+type StepDecay struct {
+    initial float64
+    drop float64
+    every int
+}
+
+func (s StepDecay) Rate (epoch int, loss float64) float64 {
+    return s.initial * math.Pow(s.drop, float64(epoch / s.every))
+}
+
+/**
+ * Create a step decay schedule, e.g. halve (0.5) the rate every 10 epochs.
+ *
+ * ErrInvalidEvery is returned when every is not above 0.
+ */
+func StepDecayFactory (initial, drop float64, every int) (StepDecay, error) {
+    if (every <= 0) {
+        return StepDecay{}, ErrInvalidEvery
+    }
+    return StepDecay{
+        initial: initial,
+        drop: drop,
+        every: every,
+    }, nil
+}
+
+/**
+ * Multiply the learning rate by decay every epoch.
+ */
+type Exponential struct {
+    initial float64
+    decay float64
+}
+
+func (s Exponential) Rate (epoch int, loss float64) float64 {
+    return s.initial * math.Pow(s.decay, float64(epoch))
+}
+
+/**
+ * Create an exponential decay schedule, decay is usually a little under 1.
+ */
+func ExponentialFactory (initial, decay float64) Exponential {
+    return Exponential{
+        initial: initial,
+        decay: decay,
+    }
+}
+
+/**
+ * Ease the learning rate from initial down to min along half a cosine wave,
+ * starting over every period epochs.
+ */
+type Cosine struct {
+    initial float64
+    min float64
+    period int
+}
+
+func (s Cosine) Rate (epoch int, loss float64) float64 {
+    progress := float64(epoch % s.period) / float64(s.period)
+    return s.min + ((s.initial - s.min) * (1 + math.Cos(math.Pi * progress)) / 2)
+}
+
+/**
+ * Create a cosine annealing schedule.
+ *
+ * ErrInvalidPeriod is returned when period is not above 0.
+ */
+func CosineFactory (initial, min float64, period int) (Cosine, error) {
+    if (period <= 0) {
+        return Cosine{}, ErrInvalidPeriod
+    }
+    return Cosine{
+        initial: initial,
+        min: min,
+        period: period,
+    }, nil
+}
+
+/**
+ * Ramp the learning rate up over the first few epochs, then hand over to
+ * another schedule, which sees epochs counted from the end of the warmup.
+ */
+type Warmup struct {
+    epochs int
+    then Schedule
+}
+
+func (s Warmup) Rate (epoch int, loss float64) float64 {
+    if (epoch < s.epochs) {
+        return s.then.Rate(0, loss) * float64(epoch + 1) / float64(s.epochs)
+    }
+    return s.then.Rate(epoch - s.epochs, loss)
+}
+
+/**
+ * Create a warmup schedule.
+ */
+func WarmupFactory (epochs int, then Schedule) Warmup {
+    return Warmup{
+        epochs: epochs,
+        then: then,
+    }
+}
+
+/**
+ * Multiply the learning rate by factor whenever the loss has not improved
+ * for patience epochs, never going below min.
+ */
+type ReduceOnPlateau struct {
+    rate float64
+    factor float64
+    patience int
+    min float64
+    best float64
+    wait int
+}
+
+func (s *ReduceOnPlateau) Rate (epoch int, loss float64) float64 {
+    if (math.IsNaN(loss)) {
+        return s.rate
+    }
+    if (loss < s.best) {
+        s.best = loss
+        s.wait = 0
+        return s.rate
+    }
+
+    s.wait++
+    if (s.wait >= s.patience) {
+        s.rate = math.Max(s.rate * s.factor, s.min)
+        s.wait = 0
+    }
+    return s.rate
+}
+
+/**
+ * Create a reduce-on-plateau schedule.
+ */
+func ReduceOnPlateauFactory (initial, factor float64, patience int, min float64) *ReduceOnPlateau {
+    return &ReduceOnPlateau{
+        rate: initial,
+        factor: factor,
+        patience: patience,
+        min: min,
+        best: math.Inf(1),
+    }
+}
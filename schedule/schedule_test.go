@@ -0,0 +1,84 @@
+package schedule
+
+import (
+    "math"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/optimizer"
+)
+
+func TestRate(t *testing.T) {
+    step, err := StepDecayFactory(0.1, 0.5, 10)
+    if err != nil {
+        t.Fatalf("StepDecayFactory(0.1, 0.5, 10) returned %v", err)
+    }
+    halving, err := StepDecayFactory(1, 0.5, 1)
+    if err != nil {
+        t.Fatalf("StepDecayFactory(1, 0.5, 1) returned %v", err)
+    }
+    cosine, err := CosineFactory(1, 0, 10)
+    if err != nil {
+        t.Fatalf("CosineFactory(1, 0, 10) returned %v", err)
+    }
+
+    tests := []struct {
+        s Schedule
+        epoch int
+        want float64
+    }{
+        {ConstantFactory(0.1), 50, 0.1},
+        {step, 9, 0.1},
+        {step, 10, 0.05},
+        {step, 25, 0.025},
+        {ExponentialFactory(1, 0.9), 2, 0.81},
+        {cosine, 0, 1},
+        {cosine, 5, 0.5},
+        {cosine, 10, 1},
+        {WarmupFactory(4, ConstantFactory(1)), 0, 0.25},
+        {WarmupFactory(4, ConstantFactory(1)), 3, 1},
+        {WarmupFactory(4, halving), 5, 0.5},
+    }
+
+    for _, test := range tests {
+        got := test.s.Rate(test.epoch, 0)
+        if math.Abs(got - test.want) > 1e-12 {
+            t.Errorf("%T.Rate(%v) == %v, want %v", test.s, test.epoch, got, test.want)
+        }
+    }
+}
+
+func TestFactoryInvalid(t *testing.T) {
+    for _, n := range []int{0, -10} {
+        if _, err := StepDecayFactory(0.1, 0.5, n); err != ErrInvalidEvery {
+            t.Errorf("StepDecayFactory(0.1, 0.5, %v) returned %v, want %v", n, err, ErrInvalidEvery)
+        }
+        if _, err := CosineFactory(1, 0, n); err != ErrInvalidPeriod {
+            t.Errorf("CosineFactory(1, 0, %v) returned %v, want %v", n, err, ErrInvalidPeriod)
+        }
+    }
+}
+
+func TestReduceOnPlateau(t *testing.T) {
+    s := ReduceOnPlateauFactory(1, 0.5, 2, 0.2)
+
+    // Before the first epoch there is no loss to judge by.
+    if s.Rate(0, math.NaN()) != 1 || s.wait != 0 {
+        t.Errorf("Rate(0, NaN) should keep the rate and not count towards patience")
+    }
+    losses := []float64{3, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+    wants := []float64{1, 1, 1, 0.5, 0.5, 0.5, 0.5, 0.25, 0.25, 0.2, 0.2, 0.2, 0.2, 0.2}
+
+    for epoch := 0; epoch < len(losses); epoch++ {
+        got := s.Rate(epoch, losses[epoch])
+        if got != wants[epoch] {
+            t.Errorf("Rate(%v, %v) == %v, want %v", epoch, losses[epoch], got, wants[epoch])
+        }
+    }
+}
+
+func TestApply(t *testing.T) {
+    o := optimizer.SGDFactory(1)
+    got := Apply(ExponentialFactory(1, 0.5), o, 1, 0)
+    if got != 0.5 || o.LearningRate() != 0.5 {
+        t.Errorf("Apply should have set the learning rate to 0.5, but got %v and %v", got, o.LearningRate())
+    }
+}
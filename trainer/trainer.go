@@ -0,0 +1,237 @@
+package trainer
+
+import (
+    "fmt"
+    "math"
+    "github.com/josephdpurcell/go-neural-network/dataset"
+    "github.com/josephdpurcell/go-neural-network/event"
+    "github.com/josephdpurcell/go-neural-network/mat"
+    "github.com/josephdpurcell/go-neural-network/perceptron"
+    "github.com/josephdpurcell/go-neural-network/random"
+    "github.com/josephdpurcell/go-neural-network/schedule"
+)
+
+var (
+    ErrEmptyDataset = fmt.Errorf("trainer: dataset has no samples")
+)
+
+/**
+ * Anything that can learn from one sample at a time, like a Network.
+ *
+ * Train returns the loss of the sample before the model learned from it, or an
+ * error when the sample does not fit the model.
+ */
+type Model interface {
+    Train (input, desired []float64) (float64, error)
+    Feedforward (input []float64) ([]float64, error)
+}
+
+/**
+ * Why training stopped.
+ */
+type Reason string
+
+const (
+    // Every epoch asked for was run.
+    Finished Reason = "finished"
+    // The model got every sample right.
+    ZeroError Reason = "zero error"
+    // The loss got as low as asked for.
+    LossThreshold Reason = "loss threshold"
+    // The loss stopped improving for longer than the patience allows.
+    EarlyStopped Reason = "early stopped"
+)
+
+/**
+ * What happened during training: one entry per epoch, and why it stopped.
+ */
+type History struct {
+    Epochs []event.Epoch
+    Reason Reason
+}
+
+/**
+ * How to train.
+ *
+ * Epochs is the most epochs to run. Training stops early once an epoch has no
+ * mistakes when StopOnZeroError is set, once the epoch's loss is at or below
+ * LossThreshold when that is above 0, or once the loss has not improved by
+ * more than MinDelta for Patience epochs when Patience is above 0.
+ *
+ * An output counts as a mistake when it is more than Tolerance away from the
+ * desired value.
+ *
+ * When Shuffle is set, the samples are put in a new order every epoch using
+ * Source.
+ *
+ * Schedule, when given, sets the learning rate before each epoch, provided the
+ * model has one to set. Observer, when given, is told about every epoch.
+ */
+type Config struct {
+    Epochs int
+    Shuffle bool
+    Source *random.Source
+    StopOnZeroError bool
+    LossThreshold float64
+    Patience int
+    MinDelta float64
+    Tolerance float64
+    Schedule schedule.Schedule
+    Observer event.Observer
+}
+
+/**
+ * Runs a model through a dataset epoch after epoch.
+ */
+type Trainer struct {
+    model Model
+    config Config
+}
+
+/**
+ * Train the model on the dataset until the config says to stop.
+ *
+ * Training stops at the first sample the model cannot use, returning the
+ * epochs finished so far and the error, naming the sample. ErrEmptyDataset
+ * is returned, without training, when there are no samples.
+ */
+func (t Trainer) Run (d dataset.Dataset) (History, error) {
+    var history History
+    if (d.Len() == 0) {
+        return history, ErrEmptyDataset
+    }
+    var last float64 = math.NaN()
+    var best float64 = math.Inf(1)
+    var wait int = 0
+
+    order := make([]int, d.Len())
+    for i := 0; i < len(order); i++ {
+        order[i] = i
+    }
+
+    for epoch := 0; epoch < t.config.Epochs; epoch++ {
+        if (t.config.Schedule != nil) {
+            if m, ok := t.model.(schedule.Adjustable); ok {
+                schedule.Apply(t.config.Schedule, m, epoch, last)
+            }
+        }
+
+        if (t.config.Shuffle) {
+            t.config.Source.Shuffle(len(order), func (i, j int) {
+                order[i], order[j] = order[j], order[i]
+            })
+        }
+
+        var sum float64 = 0
+        for _, i := range order {
+            sample := d.At(i)
+            value, err := t.model.Train(sample.Input, sample.Desired)
+            if err != nil {
+                return history, fmt.Errorf("trainer: sample %v: %w", i, err)
+            }
+            sum = sum + value
+        }
+        last = sum / float64(d.Len())
+
+        mistakes, err := t.mistakes(d)
+        if err != nil {
+            return history, err
+        }
+        e := event.Epoch{
+            Epoch: epoch,
+            Loss: last,
+            Mistakes: mistakes,
+        }
+        history.Epochs = append(history.Epochs, e)
+        event.Notify(t.config.Observer, e)
+
+        if (t.config.StopOnZeroError && e.Mistakes == 0) {
+            history.Reason = ZeroError
+            return history, nil
+        }
+        if (t.config.LossThreshold > 0 && last <= t.config.LossThreshold) {
+            history.Reason = LossThreshold
+            return history, nil
+        }
+        if (t.config.Patience > 0) {
+            if (last < best - t.config.MinDelta) {
+                best = last
+                wait = 0
+            } else {
+                wait++
+                if (wait >= t.config.Patience) {
+                    history.Reason = EarlyStopped
+                    return history, nil
+                }
+            }
+        }
+    }
+
+    history.Reason = Finished
+    return history, nil
+}
+
+/**
+ * Count the samples the model currently gets wrong.
+ */
+func (t Trainer) mistakes (d dataset.Dataset) (int, error) {
+    var count int = 0
+    for i := 0; i < d.Len(); i++ {
+        sample := d.At(i)
+        output, err := t.model.Feedforward(sample.Input)
+        if err != nil {
+            return 0, fmt.Errorf("trainer: sample %v: %w", i, err)
+        }
+        for j := 0; j < len(output); j++ {
+            if (math.Abs(output[j] - sample.Desired[j]) > t.config.Tolerance) {
+                count++
+                break
+            }
+        }
+    }
+    return count, nil
+}
+
+/**
+ * Create a Trainer.
+ */
+func TrainerFactory (model Model, config Config) Trainer {
+    t := Trainer{
+        model: model,
+        config: config,
+    }
+    return t
+}
+
+/**
+ * Lets a Perceptron, which has a single output, be used as a Model.
+ */
+type perceptronModel struct {
+    p *perceptron.Perceptron
+}
+
+func (m perceptronModel) Train (input, desired []float64) (float64, error) {
+    if err := mat.CheckLength("trainer: desired", 1, len(desired)); err != nil {
+        return 0, err
+    }
+    return m.p.Train(input, desired[0])
+}
+
+func (m perceptronModel) Feedforward (input []float64) ([]float64, error) {
+    output, err := m.p.Feedforward(input)
+    if err != nil {
+        return nil, err
+    }
+    return []float64{output}, nil
+}
+
+func (m perceptronModel) SetLearningRate (rate float64) {
+    m.p.SetLearningRate(rate)
+}
+
+/**
+ * Use a Perceptron as a Model. Training changes the given Perceptron.
+ */
+func PerceptronModel (p *perceptron.Perceptron) Model {
+    return perceptronModel{p: p}
+}
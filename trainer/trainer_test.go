@@ -0,0 +1,180 @@
+package trainer
+
+import (
+    "errors"
+    "testing"
+    "github.com/josephdpurcell/go-neural-network/activation"
+    "github.com/josephdpurcell/go-neural-network/dataset"
+    "github.com/josephdpurcell/go-neural-network/event"
+    "github.com/josephdpurcell/go-neural-network/mat"
+    "github.com/josephdpurcell/go-neural-network/network"
+    "github.com/josephdpurcell/go-neural-network/perceptron"
+    "github.com/josephdpurcell/go-neural-network/random"
+    "github.com/josephdpurcell/go-neural-network/schedule"
+)
+
+/**
+ * A model that reports the losses it is given and records learning rates.
+ */
+type fakeModel struct {
+    losses []float64
+    rates []float64
+    step int
+}
+
+func (m *fakeModel) Train (input, desired []float64) (float64, error) {
+    l := m.losses[m.step % len(m.losses)]
+    m.step++
+    return l, nil
+}
+
+func (m *fakeModel) Feedforward (input []float64) ([]float64, error) {
+    return []float64{0}, nil
+}
+
+func (m *fakeModel) SetLearningRate (rate float64) {
+    m.rates = append(m.rates, rate)
+}
+
+var nand = dataset.InMemoryFactory(
+    dataset.Sample{Input: []float64{1, 0, 0}, Desired: []float64{1}},
+    dataset.Sample{Input: []float64{1, 0, 1}, Desired: []float64{1}},
+    dataset.Sample{Input: []float64{1, 1, 0}, Desired: []float64{1}},
+    dataset.Sample{Input: []float64{1, 1, 1}, Desired: []float64{0}},
+)
+
+func TestRunZeroError(t *testing.T) {
+    p, _ := perceptron.PerceptronFactory(3, 0.1, activation.Step{Threshold: 0.5})
+    tr := TrainerFactory(PerceptronModel(&p), Config{
+        Epochs: 100,
+        StopOnZeroError: true,
+    })
+
+    history, _ := tr.Run(nand)
+    if history.Reason != ZeroError {
+        t.Errorf("Training NAND should stop at zero error, but stopped with %v", history.Reason)
+    }
+
+    last := history.Epochs[len(history.Epochs) - 1]
+    if last.Mistakes != 0 {
+        t.Errorf("Training NAND should end with no mistakes, but ended with %v", last)
+    }
+
+    for _, s := range dataset.Samples(nand) {
+        if got, _ := p.Feedforward(s.Input); got != s.Desired[0] {
+            t.Errorf("p.Feedforward(%v) == %v, want %v", s.Input, got, s.Desired[0])
+        }
+    }
+}
+
+func TestRunShuffle(t *testing.T) {
+    p, _ := perceptron.PerceptronFactory(3, 0.1, activation.Step{Threshold: 0.5})
+    tr := TrainerFactory(PerceptronModel(&p), Config{
+        Epochs: 1000,
+        Shuffle: true,
+        Source: random.SourceFactory(1),
+        StopOnZeroError: true,
+    })
+
+    history, _ := tr.Run(nand)
+    if history.Reason != ZeroError {
+        t.Errorf("Training NAND shuffled should stop at zero error, but stopped with %v", history.Reason)
+    }
+}
+
+func TestRunLossThreshold(t *testing.T) {
+    n, _ := network.NetworkFactory([]int{2, 4, 1}, 0.5, nil)
+    xor := dataset.InMemoryFactory(
+        dataset.Sample{Input: []float64{0, 0}, Desired: []float64{0}},
+        dataset.Sample{Input: []float64{0, 1}, Desired: []float64{1}},
+        dataset.Sample{Input: []float64{1, 0}, Desired: []float64{1}},
+        dataset.Sample{Input: []float64{1, 1}, Desired: []float64{0}},
+    )
+    tr := TrainerFactory(&n, Config{
+        Epochs: 5,
+        LossThreshold: 100,
+    })
+
+    // Any loss is under 100, so the first epoch is enough.
+    history, _ := tr.Run(xor)
+    if history.Reason != LossThreshold || len(history.Epochs) != 1 {
+        t.Errorf("Training should stop after 1 epoch at the loss threshold, but ran %v and stopped with %v", len(history.Epochs), history.Reason)
+    }
+}
+
+func TestRunPatience(t *testing.T) {
+    m := &fakeModel{losses: []float64{5, 4, 3, 3, 3, 3, 3, 3}}
+    tr := TrainerFactory(m, Config{
+        Epochs: 8,
+        Patience: 2,
+    })
+
+    // One sample per epoch: improves for 3 epochs, then is stuck for 2.
+    history, _ := tr.Run(dataset.InMemoryFactory(dataset.Sample{Input: []float64{0}, Desired: []float64{0}}))
+    if history.Reason != EarlyStopped || len(history.Epochs) != 5 {
+        t.Errorf("Training should stop early after 5 epochs, but ran %v and stopped with %v", len(history.Epochs), history.Reason)
+    }
+}
+
+func TestRunFinished(t *testing.T) {
+    m := &fakeModel{losses: []float64{1}}
+    var epochs []int
+    tr := TrainerFactory(m, Config{
+        Epochs: 3,
+        Schedule: schedule.ExponentialFactory(1, 0.5),
+        Observer: func (e event.Event) {
+            epochs = append(epochs, e.(event.Epoch).Epoch)
+        },
+    })
+
+    history, _ := tr.Run(dataset.InMemoryFactory(dataset.Sample{Input: []float64{0}, Desired: []float64{1}}))
+    if history.Reason != Finished || len(history.Epochs) != 3 {
+        t.Errorf("Training should run all 3 epochs, but ran %v and stopped with %v", len(history.Epochs), history.Reason)
+    }
+    if history.Epochs[0].Mistakes != 1 {
+        t.Errorf("An output of 0 for 1 should be a mistake, but counted %v", history.Epochs[0].Mistakes)
+    }
+    if len(m.rates) != 3 || m.rates[0] != 1 || m.rates[1] != 0.5 || m.rates[2] != 0.25 {
+        t.Errorf("Learning rates should have been {1, 0.5, 0.25}, but were %v", m.rates)
+    }
+    if len(epochs) != 3 || epochs[2] != 2 {
+        t.Errorf("Observer should have seen epochs {0, 1, 2}, but saw %v", epochs)
+    }
+}
+
+func TestTrainerBadSample(t *testing.T) {
+    p, _ := perceptron.PerceptronFactory(3, 0.1, activation.Step{Threshold: 0.5})
+    tr := TrainerFactory(PerceptronModel(&p), Config{Epochs: 5})
+
+    d := dataset.InMemoryFactory(
+        dataset.Sample{Input: []float64{1, 0, 0}, Desired: []float64{1}},
+        dataset.Sample{Input: []float64{1, 0}, Desired: []float64{1}},
+    )
+    history, err := tr.Run(d)
+    var dimension *mat.DimensionError
+    if !errors.As(err, &dimension) {
+        t.Fatalf("tr.Run() error == %v, want a *mat.DimensionError", err)
+    }
+    if err.Error() != "trainer: sample 1: perceptron: want 3 values, got 2" {
+        t.Errorf("err.Error() == %q", err.Error())
+    }
+    if len(history.Epochs) != 0 {
+        t.Errorf("No epoch should have finished, but %v did", len(history.Epochs))
+    }
+}
+
+func TestTrainerEmptyDataset(t *testing.T) {
+    p, _ := perceptron.PerceptronFactory(3, 0.1, activation.Step{Threshold: 0.5})
+    tr := TrainerFactory(PerceptronModel(&p), Config{
+        Epochs: 5,
+        StopOnZeroError: true,
+    })
+
+    history, err := tr.Run(dataset.InMemoryFactory())
+    if err != ErrEmptyDataset {
+        t.Errorf("tr.Run() of no samples error == %v, want %v", err, ErrEmptyDataset)
+    }
+    if len(history.Epochs) != 0 || history.Reason != "" {
+        t.Errorf("No training should have happened, but got %v", history)
+    }
+}